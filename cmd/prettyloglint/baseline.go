@@ -0,0 +1,156 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/danyarmarkin/prettyloglint/internal/analyzer"
+	"github.com/danyarmarkin/prettyloglint/internal/baseline"
+)
+
+// Коды возврата совпадают с singlechecker: 1 — ошибка, 3 — найдены нарушения
+const (
+	exitOK       = 0
+	exitError    = 1
+	exitFindings = 3
+)
+
+type baselineOptions struct {
+	writePath string // записать текущие нарушения в baseline
+	checkPath string // сообщить только о нарушениях, которых нет в baseline
+	tests     bool
+	patterns  []string
+}
+
+// parseBaselineArgs разбирает аргументы режима baseline.
+// Возвращает false, если ни -baseline, ни -baseline-write не указаны.
+func parseBaselineArgs(args []string) (baselineOptions, bool) {
+	var opts baselineOptions
+	isBaseline := false
+	for _, arg := range args {
+		name := strings.TrimLeft(strings.SplitN(arg, "=", 2)[0], "-")
+		if name == "baseline" || name == "baseline-write" {
+			isBaseline = true
+		}
+	}
+	if !isBaseline {
+		return opts, false
+	}
+	fs := flag.NewFlagSet("prettyloglint", flag.ExitOnError)
+	fs.StringVar(&opts.writePath, "baseline-write", "", "write current findings to the baseline `file`")
+	fs.StringVar(&opts.checkPath, "baseline", "", "report only findings missing from the baseline `file`")
+	fs.BoolVar(&opts.tests, "test", true, "indicates whether test files should be analyzed, too")
+	_ = fs.Parse(args)
+	opts.patterns = fs.Args()
+	return opts, true
+}
+
+func runBaseline(opts baselineOptions) int {
+	if (opts.writePath == "") == (opts.checkPath == "") {
+		fmt.Fprintln(os.Stderr, "prettyloglint: exactly one of -baseline and -baseline-write must be set")
+		return exitError
+	}
+	findings, err := collectFindings(opts.patterns, opts.tests)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "prettyloglint: %v\n", err)
+		return exitError
+	}
+
+	if opts.writePath != "" {
+		if err := baseline.New(findings).Write(opts.writePath); err != nil {
+			fmt.Fprintf(os.Stderr, "prettyloglint: %v\n", err)
+			return exitError
+		}
+		fmt.Fprintf(os.Stderr, "prettyloglint: wrote %d findings to %s\n", len(findings), opts.writePath)
+		return exitOK
+	}
+
+	b, err := baseline.Load(opts.checkPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "prettyloglint: %v\n", err)
+		return exitError
+	}
+	fresh := b.Filter(findings)
+	printFindings(os.Stderr, fresh)
+	if len(fresh) > 0 {
+		return exitFindings
+	}
+	return exitOK
+}
+
+// collectFindings загружает пакеты, запускает анализатор и возвращает нарушения
+// с путями относительно текущего каталога
+func collectFindings(patterns []string, tests bool) ([]baseline.Finding, error) {
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	cfg := &packages.Config{Mode: packages.LoadAllSyntax, Tests: tests}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, err
+	}
+	if n := packages.PrintErrors(pkgs); n > 0 {
+		return nil, fmt.Errorf("%d errors while loading packages", n)
+	}
+	graph, err := checker.Analyze([]*analysis.Analyzer{analyzer.Analyzer}, pkgs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	// при анализе тестов файлы пакета попадают и в тестовый вариант пакета — убираем повторы
+	seen := make(map[baseline.Finding]bool)
+	var findings []baseline.Finding
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			return nil, act.Err
+		}
+		for _, d := range act.Diagnostics {
+			pos := act.Package.Fset.Position(d.Pos)
+			file := pos.Filename
+			if rel, err := filepath.Rel(wd, file); err == nil {
+				file = rel
+			}
+			f := baseline.Finding{
+				File:    filepath.ToSlash(file),
+				Line:    pos.Line,
+				Column:  pos.Column,
+				Rule:    d.Category,
+				Message: d.Message,
+			}
+			if !seen[f] {
+				seen[f] = true
+				findings = append(findings, f)
+			}
+		}
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return findings, nil
+}
+
+func printFindings(w io.Writer, findings []baseline.Finding) {
+	for _, f := range findings {
+		fmt.Fprintln(w, f)
+	}
+}
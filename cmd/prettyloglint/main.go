@@ -1,11 +1,17 @@
 package main
 
 import (
+	"os"
+
 	"github.com/danyarmarkin/prettyloglint/internal/analyzer"
 
 	"golang.org/x/tools/go/analysis/singlechecker"
 )
 
 func main() {
+	// режим baseline: prettyloglint -baseline-write=baseline.json ./... или prettyloglint -baseline=baseline.json ./...
+	if opts, ok := parseBaselineArgs(os.Args[1:]); ok {
+		os.Exit(runBaseline(opts))
+	}
 	singlechecker.Main(analyzer.Analyzer)
 }
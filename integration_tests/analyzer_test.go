@@ -3,6 +3,7 @@ package integration_tests
 import (
 	"testing"
 
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
 
 	"github.com/danyarmarkin/prettyloglint/internal/analyzer"
@@ -22,3 +23,158 @@ func TestAnalyzerZap(t *testing.T) {
 	testdata := analysistest.TestData()
 	analysistest.Run(t, testdata, analyzer.Analyzer, "zap")
 }
+
+func TestAnalyzerZerolog(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, analyzer.Analyzer, "zerolog")
+}
+
+func TestAnalyzerLogrus(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, analyzer.Analyzer, "logrus")
+}
+
+func TestAnalyzerCustomLoggers(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Loggers = []analyzer.LoggerConfig{
+		{Package: "corp/logging", Methods: []string{"Event"}, MessageIndex: 1, Fields: analyzer.FieldsKeyValue},
+		{Package: "corp/logging", Type: "Logger", Methods: []string{"Notice"}, Fields: analyzer.FieldsKeyValue},
+		{Package: "corp/logging", Type: "*Logger", Methods: []string{"Noticef"}, Printf: true, Fields: analyzer.FieldsNone},
+		{Package: "corp/logging", Type: "Logger", Methods: []string{"Audit"}, MessageIndex: 1, Fields: analyzer.FieldsConstructors},
+		{Package: "corp/logging", Type: "Logger", Methods: []string{"Tagged"}, MessageIndex: -1, Fields: analyzer.FieldsMap},
+		{Package: "corp/logging", Type: "Entry", Methods: []string{"Msg"}, Fields: analyzer.FieldsChain},
+	}
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, newAnalyzer(t, cfg), "custom")
+}
+
+func TestAnalyzerWrappers(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, analyzer.Analyzer, "wrappers")
+}
+
+func TestAnalyzerRules(t *testing.T) {
+	disabled := false
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = map[string]analyzer.RuleConfig{
+		analyzer.RuleEnglishOnly:       {Enabled: &disabled},
+		analyzer.RuleDisallowedSymbols: {Severity: analyzer.SeverityWarning},
+		analyzer.RuleSensitiveData:     {Severity: analyzer.SeverityInfo},
+	}
+	testdata := analysistest.TestData()
+	results := analysistest.Run(t, testdata, newAnalyzer(t, cfg), "rules")
+	for _, result := range results {
+		for _, d := range result.Diagnostics {
+			switch d.Category {
+			case analyzer.RuleLowercaseStart, analyzer.RuleDisallowedSymbols, analyzer.RuleSensitiveData:
+			default:
+				t.Errorf("unexpected diagnostic category %q", d.Category)
+			}
+		}
+	}
+}
+
+func TestAnalyzerFixes(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.Analyzer, "fixes")
+}
+
+func TestAnalyzerDirectives(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.ReportUnusedDirectives = true
+	cfg.RequireDirectiveReason = true
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, newAnalyzer(t, cfg), "directives")
+}
+
+func TestAnalyzerConstants(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.Analyzer, "constants")
+}
+
+func TestAnalyzerPrintf(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.Analyzer, "printf")
+}
+
+func TestAnalyzerStructuredMessage(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer.Analyzer, "structured/...")
+}
+
+func TestAnalyzerKeyStyle(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.KeyStyle = analyzer.KeyStyleSnakeCase
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, newAnalyzer(t, cfg), "keystyle")
+}
+
+func TestAnalyzerDuplicateKeys(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, analyzer.Analyzer, "duplicates")
+}
+
+func TestAnalyzerKVPairs(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, analyzer.Analyzer, "kvpairs")
+}
+
+func TestAnalyzerSafeKeys(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.SafeKeys = []string{"csrf_token"}
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, newAnalyzer(t, cfg), "safekeys")
+}
+
+func TestAnalyzerSecrets(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, analyzer.Analyzer, "secrets")
+}
+
+func TestAnalyzerPII(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.PII.Phone = false
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, newAnalyzer(t, cfg), "pii")
+}
+
+func TestAnalyzerSensitiveValue(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, analyzer.Analyzer, "sensitivevalue")
+}
+
+func TestAnalyzerSensitiveTypes(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.SensitiveTypes = []string{"sensitivetypes/secrets.APIKey"}
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, newAnalyzer(t, cfg), "sensitivetypes")
+}
+
+func TestAnalyzerRedactor(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Redactor = "corp/redact.String"
+	testdata := analysistest.TestData()
+	analysistest.RunWithSuggestedFixes(t, testdata, newAnalyzer(t, cfg), "redact/...")
+}
+
+func TestAnalyzerSensitivePatterns(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.CustomSensitivePatterns = []string{`internal.?id`}
+	cfg.SensitivePatterns = []analyzer.SensitivePattern{
+		{Name: "employee-id", Pattern: `\bEMP-\d{6}\b`, CaseSensitive: true, Targets: []string{analyzer.PatternTargetValue}},
+		{Name: "tenant", Pattern: `^tenant`, Targets: []string{analyzer.PatternTargetKey}},
+		{Name: "ssn", Pattern: `\b\d{3}-\d{2}-\d{4}\b`, Targets: []string{analyzer.PatternTargetMessage, analyzer.PatternTargetValue}},
+	}
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, newAnalyzer(t, cfg), "patterns")
+}
+
+// newAnalyzer создаёт анализатор с настройками cfg и завершает тест при ошибке конфигурации
+func newAnalyzer(t *testing.T, cfg analyzer.Config) *analysis.Analyzer {
+	t.Helper()
+	a, err := analyzer.NewAnalyzer(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
@@ -0,0 +1,39 @@
+package constants
+
+import (
+	"log/slog"
+
+	"constants/msgs"
+	"go.uber.org/zap"
+)
+
+const msgServerStarted = "Server started"
+
+const (
+	msgPrefix     = "Request "
+	msgRequestEnd = msgPrefix + "completed"
+)
+
+type event string
+
+const msgCacheMiss event = "cache miss!!"
+
+var msgShutdown = "Shutting down"
+
+var msgDynamic = buildMessage()
+
+func buildMessage() string { return "Dynamic" }
+
+func Examples() {
+	slog.Info(msgServerStarted)     // want "log message should start"
+	slog.Warn(string(msgCacheMiss)) // want "contains disallowed symbol or emoji"
+	slog.Info(msgRequestEnd)        // want "log message should start"
+	slog.Info(string(msgs.Started)) // want "log message should start"
+	slog.Info(msgs.Stopped)
+	slog.Info(msgShutdown) // want "log message should start"
+	slog.Info(msgDynamic)
+	slog.Info(msgServerStarted + "!!") // want "log message should start" "contains disallowed symbol or emoji"
+
+	logger, _ := zap.NewProduction()
+	logger.Info(msgServerStarted) // want "log message should start"
+}
@@ -0,0 +1,8 @@
+package msgs
+
+type Message string
+
+const (
+	Started Message = "Service started"
+	Stopped         = "service stopped"
+)
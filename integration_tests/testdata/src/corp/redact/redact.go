@@ -0,0 +1,4 @@
+package redact
+
+// String скрывает значение в логе
+func String(s string) string { return "***" }
@@ -0,0 +1,22 @@
+package custom
+
+import (
+	"context"
+
+	"corp/logging"
+)
+
+var password = "secret"
+
+func BadExamples(ctx context.Context) {
+	logging.Event(ctx, "Service started")            // want "log message should start"
+	logging.Event(ctx, "user logged in", "token", 1) // want "may contain sensitive data"
+
+	logger := logging.New()
+	logger.Notice("запуск сервера")                                  // want "should contain only English"
+	logger.Noticef("Request failed")                                 // want "log message should start"
+	logger.Audit(ctx, "access granted", logging.Str("api_key", "k")) // want "may contain sensitive data"
+	logger.Tagged(map[string]string{"secret": password})             // want "may contain sensitive data"
+	logger.Entry().KV("token").Msg("Request handled")                // want "log message should start"
+	logger.Entry().Str("password", password).Msg("request handled")  // want "may contain sensitive data"
+}
@@ -0,0 +1,21 @@
+package custom
+
+import (
+	"context"
+
+	"corp/logging"
+)
+
+func GoodExamples(ctx context.Context) {
+	logging.Event(ctx, "service started", "user", "alice")
+
+	logger := logging.New()
+	logger.Notice("cache warmed up", "entries", 10)
+	logger.Audit(ctx, "access granted", logging.Str("user", "alice"))
+	logger.Tagged(map[string]string{"env": "prod"})
+	logger.Entry().Str("user", "alice").Msg("request handled")
+	logger.Entry().KV("user").Msg("request handled")
+	logger.Entry().KV("attempt", 1, 2).Msg("request retried")
+	values := []interface{}{1, 2}
+	logger.Entry().KV("token", values...).Msg("request retried")
+}
@@ -0,0 +1,27 @@
+package directives
+
+import "log/slog"
+
+//prettyloglint:file-ignore english-only -- legacy messages are translated later
+
+func Examples(count int) {
+	slog.Info("запуск")
+	slog.Info("token", "count", count) //prettyloglint:ignore sensitive-data -- key is a token count
+
+	//prettyloglint:ignore lowercase-start,disallowed-symbols -- generated message
+	slog.Warn(
+		"Server started!",
+		"port", 8080,
+	)
+
+	//prettyloglint:ignore -- whole block is intentional
+	if count > 0 {
+		slog.Info("Password reset!")
+		slog.Debug("Token refreshed")
+	}
+
+	slog.Info("Request failed") //prettyloglint:ignore sensitive-data -- wrong rule // want "log message should start" "unused prettyloglint directive"
+	slog.Info("request failed") //prettyloglint:ignore // want "unused prettyloglint directive" "should explain the reason"
+	slog.Info("Token expired")  //prettyloglint:ignore all // want "should explain the reason"
+	slog.Info("ok")             //prettyloglint:ignore no-such-rule -- typo // want "unknown rule \"no-such-rule\"" "unused prettyloglint directive"
+}
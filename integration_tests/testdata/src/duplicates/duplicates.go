@@ -0,0 +1,57 @@
+package duplicates
+
+import (
+	"log/slog"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+func GoodExamples(a, b string) {
+	logger, _ := zap.NewProduction()
+
+	logger.Info("user logged in", zap.String("id", a), zap.String("session", b))
+	slog.Info("user logged in", "id", a, slog.Group("auth", "id", b))
+	log.Info().Str("id", a).Dict("auth", zerolog.Dict().Str("id", b)).Msg("user logged in")
+
+	l := logger.With(zap.String("id", a))
+	l.Info("user logged in", zap.String("session", b))
+
+	// поля после WithGroup попадают в группу
+	g := slog.With("id", a).WithGroup("request")
+	g.Info("started", "id", b)
+
+	// переменная переприсвоена логеру без привязанных полей
+	l = logger
+	l.Info("user logged in", zap.String("id", b))
+}
+
+func BadExamples(a, b string) {
+	logger, _ := zap.NewProduction()
+
+	logger.Info("user logged in", zap.String("id", a), zap.Int64("id", 1))                  // want `duplicate log field key "id"`
+	slog.Info("user logged in", "id", a, "id", b)                                           // want `duplicate log field key "id"`
+	slog.Info("user logged in", slog.Group("auth", "id", a, "id", b))                       // want `duplicate log field key "auth.id"`
+	log.Info().Str("id", a).Str("id", b).Msg("user logged in")                              // want `duplicate log field key "id"`
+	log.Info().Dict("auth", zerolog.Dict().Str("id", a).Str("id", b)).Msg("user logged in") // want `duplicate log field key "auth.id"`
+
+	l := logger.With(zap.String("id", a))
+	l.Info("user logged in", zap.String("id", b)) // want `duplicate log field key "id": already bound by With`
+	l.Sugar().Infow("user logged in", "id", b)    // want `duplicate log field key "id": already bound by With`
+	l = l.With(zap.String("session", b))
+	l.Warn("session expired", zap.String("id", b))                    // want `duplicate log field key "id": already bound by With`
+	l.Named("auth").Warn("session expired", zap.String("session", a)) // want `duplicate log field key "session": already bound by With`
+
+	logger.With(zap.String("id", a)).Error("failed", zap.String("id", b)) // want `duplicate log field key "id": already bound by With`
+
+	s := slog.With("id", a)
+	s.Info("user logged in", "id", b) // want `duplicate log field key "id": already bound by With`
+
+	entry := logrus.WithField("id", a)
+	entry.WithFields(logrus.Fields{"id": b}).Info("user logged in") // want `duplicate log field key "id": already bound by With`
+
+	zl := log.With().Str("id", a).Logger()
+	zl.Info().Str("id", b).Msg("user logged in") // want `duplicate log field key "id": already bound by With`
+}
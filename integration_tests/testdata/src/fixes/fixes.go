@@ -0,0 +1,11 @@
+package fixes
+
+import "log/slog"
+
+func Examples(name string) {
+	slog.Info("Connection failed!!!")          // want "log message should start" "contains disallowed symbol or emoji"
+	slog.Warn("Сервер: started")               // want "log message should start" "should contain only English"
+	slog.Error("Request failed, код: " + name) // want "log message should start" "should contain only English" "built dynamically"
+	slog.Debug("done" + " Окей!")              // want "should contain only English" "contains disallowed symbol or emoji"
+	slog.Debug("Done" + " Sent!")              // want "log message should start" "contains disallowed symbol or emoji"
+}
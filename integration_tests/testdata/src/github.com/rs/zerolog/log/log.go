@@ -0,0 +1,14 @@
+package log
+
+// Minimal stub of github.com/rs/zerolog/log used only for analysistest.
+
+import "github.com/rs/zerolog"
+
+var Logger = zerolog.New()
+
+func Info() *zerolog.Event  { return Logger.Info() }
+func Debug() *zerolog.Event { return Logger.Debug() }
+func Error() *zerolog.Event { return Logger.Error() }
+func Warn() *zerolog.Event  { return Logger.Warn() }
+
+func With() zerolog.Context { return Logger.With() }
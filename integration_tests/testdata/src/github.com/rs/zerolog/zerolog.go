@@ -0,0 +1,42 @@
+package zerolog
+
+// Minimal stub of github.com/rs/zerolog used only for analysistest.
+
+type Logger struct{}
+
+type Event struct{}
+
+type Context struct{}
+
+func New() Logger { return Logger{} }
+
+// Logger methods
+func (l Logger) Info() *Event  { return &Event{} }
+func (l Logger) Debug() *Event { return &Event{} }
+func (l Logger) Error() *Event { return &Event{} }
+func (l Logger) Warn() *Event  { return &Event{} }
+
+func (l Logger) With() Context { return Context{} }
+
+// Context fields
+func (c Context) Str(key, val string) Context   { return c }
+func (c Context) Int(key string, i int) Context { return c }
+func (c Context) Timestamp() Context            { return c }
+func (c Context) Logger() Logger                { return Logger{} }
+
+// Dict creates a sub-dictionary event for Event.Dict.
+func Dict() *Event { return &Event{} }
+
+// Event fields
+func (e *Event) Str(key, val string) *Event                 { return e }
+func (e *Event) Int(key string, i int) *Event               { return e }
+func (e *Event) Interface(key string, i interface{}) *Event { return e }
+func (e *Event) Any(key string, i interface{}) *Event       { return e }
+func (e *Event) Dict(key string, dict *Event) *Event        { return e }
+func (e *Event) Err(err error) *Event                       { return e }
+func (e *Event) Timestamp() *Event                          { return e }
+
+// Event terminal methods
+func (e *Event) Msg(msg string)                       {}
+func (e *Event) Msgf(format string, v ...interface{}) {}
+func (e *Event) Send()                                {}
@@ -0,0 +1,53 @@
+package logrus
+
+// Minimal stub of github.com/sirupsen/logrus used only for analysistest.
+
+type Fields map[string]interface{}
+
+type Logger struct{}
+
+type Entry struct{}
+
+func New() *Logger { return &Logger{} }
+
+func NewEntry(logger *Logger) *Entry { return &Entry{} }
+
+// Package-level functions
+func WithField(key string, value interface{}) *Entry { return &Entry{} }
+func WithFields(fields Fields) *Entry                { return &Entry{} }
+func Trace(args ...interface{})                      {}
+func Debug(args ...interface{})                      {}
+func Info(args ...interface{})                       {}
+func Print(args ...interface{})                      {}
+func Warn(args ...interface{})                       {}
+func Warning(args ...interface{})                    {}
+func Error(args ...interface{})                      {}
+func Fatal(args ...interface{})                      {}
+func Panic(args ...interface{})                      {}
+func Infof(format string, args ...interface{})       {}
+func Errorf(format string, args ...interface{})      {}
+func Infoln(args ...interface{})                     {}
+
+// Logger methods
+func (l *Logger) WithField(key string, value interface{}) *Entry { return &Entry{} }
+func (l *Logger) WithFields(fields Fields) *Entry                { return &Entry{} }
+func (l *Logger) Trace(args ...interface{})                      {}
+func (l *Logger) Info(args ...interface{})                       {}
+func (l *Logger) Print(args ...interface{})                      {}
+func (l *Logger) Error(args ...interface{})                      {}
+func (l *Logger) Infof(format string, args ...interface{})       {}
+func (l *Logger) Infoln(args ...interface{})                     {}
+func (l *Logger) Panic(args ...interface{})                      {}
+
+// Entry methods
+func (e *Entry) WithField(key string, value interface{}) *Entry { return e }
+func (e *Entry) WithFields(fields Fields) *Entry                { return e }
+func (e *Entry) Trace(args ...interface{})                      {}
+func (e *Entry) Debug(args ...interface{})                      {}
+func (e *Entry) Info(args ...interface{})                       {}
+func (e *Entry) Print(args ...interface{})                      {}
+func (e *Entry) Warn(args ...interface{})                       {}
+func (e *Entry) Error(args ...interface{})                      {}
+func (e *Entry) Fatal(args ...interface{})                      {}
+func (e *Entry) Errorf(format string, args ...interface{})      {}
+func (e *Entry) Warnln(args ...interface{})                     {}
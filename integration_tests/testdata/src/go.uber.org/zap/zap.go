@@ -3,23 +3,80 @@ package zap
 // Minimal stub of go.uber.org/zap used only for analysistest.
 // Keeps signatures needed by testdata/simple/bad.go so type-based detection works.
 
+import "time"
+
 type Logger struct{}
 
+type SugaredLogger struct{}
+
 type Field struct{}
 
+type Option interface{}
+
 func NewProduction() (*Logger, error) {
 	return &Logger{}, nil
 }
 
 func (l *Logger) Sync() error { return nil }
 
+func (l *Logger) Sugar() *SugaredLogger { return &SugaredLogger{} }
+
+func (l *Logger) With(fields ...Field) *Logger       { return l }
+func (l *Logger) WithOptions(opts ...Option) *Logger { return l }
+func (l *Logger) Named(s string) *Logger             { return l }
+
+func (s *SugaredLogger) With(args ...interface{}) *SugaredLogger { return s }
+func (s *SugaredLogger) Named(name string) *SugaredLogger        { return s }
+
+// Options
+func Fields(fs ...Field) Option { return nil }
+func AddCaller() Option         { return nil }
+
 // Logger methods
 func (l *Logger) Info(msg string, args ...interface{})  {}
 func (l *Logger) Debug(msg string, args ...interface{}) {}
 func (l *Logger) Error(msg string, args ...interface{}) {}
 func (l *Logger) Warn(msg string, args ...interface{})  {}
 
+// SugaredLogger methods
+func (s *SugaredLogger) Info(args ...interface{})   {}
+func (s *SugaredLogger) Debug(args ...interface{})  {}
+func (s *SugaredLogger) Error(args ...interface{})  {}
+func (s *SugaredLogger) Warn(args ...interface{})   {}
+func (s *SugaredLogger) DPanic(args ...interface{}) {}
+func (s *SugaredLogger) Panic(args ...interface{})  {}
+func (s *SugaredLogger) Fatal(args ...interface{})  {}
+
+func (s *SugaredLogger) Infof(template string, args ...interface{})   {}
+func (s *SugaredLogger) Debugf(template string, args ...interface{})  {}
+func (s *SugaredLogger) Errorf(template string, args ...interface{})  {}
+func (s *SugaredLogger) Warnf(template string, args ...interface{})   {}
+func (s *SugaredLogger) DPanicf(template string, args ...interface{}) {}
+func (s *SugaredLogger) Panicf(template string, args ...interface{})  {}
+func (s *SugaredLogger) Fatalf(template string, args ...interface{})  {}
+
+func (s *SugaredLogger) Infow(msg string, keysAndValues ...interface{})   {}
+func (s *SugaredLogger) Debugw(msg string, keysAndValues ...interface{})  {}
+func (s *SugaredLogger) Errorw(msg string, keysAndValues ...interface{})  {}
+func (s *SugaredLogger) Warnw(msg string, keysAndValues ...interface{})   {}
+func (s *SugaredLogger) DPanicw(msg string, keysAndValues ...interface{}) {}
+func (s *SugaredLogger) Panicw(msg string, keysAndValues ...interface{})  {}
+func (s *SugaredLogger) Fatalw(msg string, keysAndValues ...interface{})  {}
+
+func (s *SugaredLogger) Infoln(args ...interface{})   {}
+func (s *SugaredLogger) Debugln(args ...interface{})  {}
+func (s *SugaredLogger) Errorln(args ...interface{})  {}
+func (s *SugaredLogger) Warnln(args ...interface{})   {}
+func (s *SugaredLogger) DPanicln(args ...interface{}) {}
+func (s *SugaredLogger) Panicln(args ...interface{})  {}
+func (s *SugaredLogger) Fatalln(args ...interface{})  {}
+
 // Field constructors used in tests
-func String(key, val string) Field          { return Field{} }
-func Int64(key string, val int64) Field     { return Field{} }
-func Any(key string, val interface{}) Field { return Field{} }
+func String(key, val string) Field                 { return Field{} }
+func Int64(key string, val int64) Field            { return Field{} }
+func Any(key string, val interface{}) Field        { return Field{} }
+func Reflect(key string, val interface{}) Field    { return Field{} }
+func Int(key string, val int) Field                { return Field{} }
+func Duration(key string, val time.Duration) Field { return Field{} }
+func Error(err error) Field                        { return Field{} }
+func NamedError(key string, err error) Field       { return Field{} }
@@ -0,0 +1,32 @@
+package keystyle
+
+import (
+	"log/slog"
+
+	"github.com/rs/zerolog/log"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+func GoodExamples(id string) {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("user logged in", "user_id", id, slog.String("request_id", id))
+	logger.Info("user logged in", zap.String("user_id", id))
+	logger.Named("AuthService").Info("started")
+	slog.Default().WithGroup("HTTP").Info("started")
+	log.Info().Str("user_id", id).Msg("user logged in")
+	logrus.WithFields(logrus.Fields{"user_id": id}).Info("user logged in")
+}
+
+func BadExamples(id string) {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("user logged in", "userId", id)                              // want `log field key "userId" should be snake_case`
+	slog.Info("user logged in", slog.String("request-id", id))             // want `log field key "request-id" should be snake_case`
+	slog.Info("user logged in", slog.Group("HTTPRequest", "ID", id))       // want `log field key "HTTPRequest" should be snake_case` `log field key "ID" should be snake_case`
+	logger.Info("user logged in", zap.String("UserID", id))                // want `log field key "UserID" should be snake_case`
+	log.Info().Str("userName", id).Msg("user logged in")                   // want `log field key "userName" should be snake_case`
+	logrus.WithField("sessionId", id).Info("user logged in")               // want `log field key "sessionId" should be snake_case`
+	logrus.WithFields(logrus.Fields{"user id": id}).Info("user logged in") // want `log field key "user id" should be snake_case`
+}
@@ -0,0 +1,37 @@
+package kvpairs
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type userID string
+
+func GoodExamples(ctx context.Context, key string, v any, args []any) {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+
+	slog.Info("user logged in", "id", 1, slog.String("name", "bob"), "admin", true)
+	slog.InfoContext(ctx, "user logged in", key, 1)
+	slog.Info("user logged in", v, 1)
+	slog.Info("user logged in", args...)
+	slog.Info("user logged in", slog.Group("auth", "method", "token"))
+	sugar.Infow("user logged in", "id", 1, zap.String("name", "bob"))
+	sugar.With("id", 1).Info("user logged in")
+}
+
+func BadExamples(ctx context.Context, id userID) {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+
+	slog.Info("user logged in", "user")                               // want `log field key "user" has no value`
+	slog.Info("user logged in", "id", 1, "admin")                     // want `log field key "admin" has no value`
+	slog.ErrorContext(ctx, "request failed", 500, "timeout")          // want `log field key 500 should be a string, got int`
+	slog.Info("user logged in", id, 1)                                // want `log field key id should be a string, got kvpairs.userID`
+	slog.Info("user logged in", slog.Group("auth", "method"))         // want `log field key "method" has no value`
+	slog.With("id").Info("user logged in")                            // want `log field key "id" has no value`
+	sugar.Infow("user logged in", 42, "x")                            // want `log field key 42 should be a string, got int`
+	sugar.Warnw("user logged in", "id", 1, zap.String("a", "b"), "x") // want `log field key "x" has no value`
+}
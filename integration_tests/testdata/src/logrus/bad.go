@@ -0,0 +1,32 @@
+package simple
+
+import "github.com/sirupsen/logrus"
+
+var (
+	password = "secret"
+	token    = "tok"
+)
+
+func BadExamples() {
+	logrus.Info("Starting server on port 8080")  // want "log message should start"
+	logrus.Print("ошибка подключения")           // want "should contain only English"
+	logrus.Warning("server started! 🚀")          // want "contains disallowed symbol or emoji"
+	logrus.Trace("user password: " + password)   // want "may contain sensitive data"
+	logrus.Infoln("Service stopped")             // want "log message should start"
+	logrus.Fatal("connection failed!!!")         // want "contains disallowed symbol or emoji"
+	logrus.WithField("secret", token).Info("ok") // want "may contain sensitive data"
+
+	logger := logrus.New()
+	logger.Trace("Trace message")   // want "log message should start"
+	logger.Panic("token: " + token) // want "may contain sensitive data"
+	logger.Infoln("запуск")         // want "should contain only English"
+	logger.WithFields(logrus.Fields{
+		"user":     "bob",
+		"password": password, // want "may contain sensitive data"
+	}).Error("login failed")
+
+	entry := logrus.NewEntry(logger)
+	entry.Warnln("Disk is almost full")                        // want "log message should start"
+	entry.WithField("api_key", "k").Debug("request sent")      // want "may contain sensitive data"
+	entry.WithFields(logrus.Fields{"token": token}).Info("ok") // want "may contain sensitive data"
+}
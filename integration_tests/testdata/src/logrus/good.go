@@ -0,0 +1,18 @@
+package simple
+
+import "github.com/sirupsen/logrus"
+
+func GoodExamples() {
+	logrus.Info("starting server on port 8080")
+	logrus.Print("failed to connect to database")
+	logrus.WithField("user", "alice").Info("user logged in")
+
+	logger := logrus.New()
+	logger.Trace("cache warmed up")
+	logger.WithFields(logrus.Fields{"path": "/api", "status": 200}).Error("request failed")
+
+	entry := logrus.NewEntry(logger)
+	entry.WithField("attempt", 1).Warn("retrying request")
+	// значения полей не проверяются как ключи
+	entry.WithField("field", "password").Debug("validation failed")
+}
@@ -0,0 +1,28 @@
+package patterns
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func GoodExamples() {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("employee EMP-123456 checked in")                 // шаблон employee-id применяется только к значениям
+	slog.Info("badge issued", "badge", "emp-123456")            // шаблон employee-id учитывает регистр
+	slog.Info("tenant created")                                 // шаблон tenant применяется только к ключам
+	slog.Info("request finished", "owner", "tenant_acme")       // и не применяется к значениям
+	logger.Info("request finished", zap.String("route", "/v1")) // значения без совпадений не сообщаются
+}
+
+func BadExamples() {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("lookup by InternalID done")                              // want `log message may contain sensitive data \(found "internal\.\?id"\)`
+	slog.Info("request finished", "internal_id", 42)                    // want `may contain sensitive data \(found "internal\.\?id"\): "internal_id"`
+	slog.Info("badge issued", "badge", "EMP-123456")                    // want `log field "badge" value may contain sensitive data \(found "employee-id"\)`
+	slog.Info("request finished", "tenant_name", "acme")                // want `may contain sensitive data \(found "tenant"\): "tenant_name"`
+	slog.Info("applicant 078-05-1120 verified")                         // want `log message may contain sensitive data \(found "ssn"\)`
+	logger.Info("applicant verified", zap.String("ref", "078-05-1120")) // want `log field "ref" value may contain sensitive data \(found "ssn"\)`
+}
@@ -0,0 +1,27 @@
+package pii
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const supportAddress = "support@example.com"
+
+func GoodExamples(addr string) {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("server started", "listen", "127.0.0.1:8080")
+	slog.Info("order created", "order_id", "4111111111111112")
+	slog.Info("callback scheduled", "contact", "+14155552671") // номера телефонов не проверяются
+	logger.Info("client connected", zap.String("remote", addr))
+}
+
+func BadExamples() {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("client 10.0.0.5 connected")                                    // want `may contain personal data \(ip-address\)` `disallowed symbol or emoji: "."`
+	slog.Info("ticket opened", "reply_to", supportAddress)                    // want `log field "reply_to" may contain personal data \(email\)`
+	slog.Info("client connected", "remote", "2001:db8::8a2e:370:7334")        // want `log field "remote" may contain personal data \(ip-address\)`
+	logger.Info("payment declined", zap.String("pan", "4111 1111 1111 1111")) // want `log field "pan" may contain personal data \(card-number\)`
+}
@@ -0,0 +1,82 @@
+package printf
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type user struct {
+	name string
+	age  int
+}
+
+func (u user) String() string { return u.name }
+
+type point struct {
+	x, y int
+}
+
+const msgRetry = "retry %d of %d"
+
+func GoodExamples() {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+	err := errors.New("timeout")
+
+	sugar.Infof("request took %d ms", 10)
+	sugar.Errorf("request failed: %v", err)
+	sugar.Warnf("user %s logged in", user{name: "bob"})
+	sugar.Debugf("progress %d%%", 50)
+	sugar.Infof("point %d", point{1, 2})
+	sugar.Infof("elapsed %s", time.Second)
+	sugar.Infof("value %[1]d (%[1]x)", 255)
+	sugar.Infof("padded %*d", 5, 42)
+	sugar.Infof(msgRetry, 1, 3)
+	logrus.Infof("request %q done", "id")
+	log.Info().Msgf("took %.2f seconds", 1.5)
+	logrus.Info(fmt.Sprintf("%d items", 3) + " done")
+
+	args := []interface{}{1, 2}
+	sugar.Infof("retry %d of %d", args...)
+}
+
+func BadExamples() {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+
+	sugar.Infof("request took %d ms", "slow")           // want `log format %d has arg "slow" of wrong type string`
+	sugar.Infof("user %s logged in")                    // want `log format %s reads arg #1, but call has 0 args`
+	sugar.Infof("request took %d ms", 10, 20)           // want `log call needs 1 args but has 2 args`
+	sugar.Errorf("request failed: %w", errors.New("x")) // want `log format %w uses error-wrapping directive %w`
+	sugar.Infof("ratio %y", 1)                          // want `log format %y has unknown verb y`
+	sugar.Infof("progress 100%")                        // want `log format "progress 100%" is missing verb at end of string`
+	sugar.Infof("flag %t", 1)                           // want `log format %t has arg 1 of wrong type int`
+	sugar.Infof("padded %*d", "5", 42)                  // want `log format %\*d uses non-int "5" as argument of \*`
+	sugar.Infof("Request took %d ms", 10)               // want "log message should start"
+	sugar.Infof(msgRetry, 1)                            // want `log format %d reads arg #2, but call has 1 args`
+	logrus.Errorf("user %d not found", "bob")           // want `log format %d has arg "bob" of wrong type string`
+	log.Info().Msgf("took %f seconds", "1.5")           // want `log format %f has arg "1.5" of wrong type string`
+
+	slog.Info("user %s logged in", "bob") // want `log message contains formatting directive %s, but Info is not a printf-style method` "contains disallowed symbol or emoji" `log field key "bob" has no value`
+	sugar.Info("request took %d ms", 10)  // want `log message contains formatting directive %d, but Info is not a printf-style method` "contains disallowed symbol or emoji"
+	slog.Warn("disk usage at 50% now")    // want "contains disallowed symbol or emoji"
+	logrus.Warn("retry %v of 3", 1)       // want `log message contains formatting directive %v, but Warn is not a printf-style method` "contains disallowed symbol or emoji"
+
+	n := 3
+	slog.Info(fmt.Sprintf("%d items", n) + " done")      // want "log message is built dynamically"
+	logrus.Info(fmt.Sprintf("%d items", n) + " %s done") // want `log message contains formatting directive %s, but Info is not a printf-style method` "contains disallowed symbol or emoji"
+}
+
+func FixExamples() {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+
+	sugar.Infof("Request %s took %d ms!!!", "id", 10) // want "log message should start" "contains disallowed symbol or emoji"
+	sugar.Warnf("%s: Запуск сервера", "api")          // want "should contain only English"
+}
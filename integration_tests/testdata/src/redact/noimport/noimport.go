@@ -0,0 +1,7 @@
+package noimport
+
+import "log/slog"
+
+func Examples(token string) {
+	slog.Info("request signed", "token", token) // want `may contain sensitive data \(found "token"\)`
+}
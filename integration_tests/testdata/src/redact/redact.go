@@ -0,0 +1,25 @@
+package redact
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+
+	"corp/redact"
+)
+
+type User struct {
+	Name     string
+	Password string
+}
+
+func Examples(u User, password string, pin int) {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+
+	slog.Info("login attempt", "password", password)           // want `may contain sensitive data \(found "password"\)`
+	logger.Info("login attempt", zap.String("secret", u.Name)) // want `may contain sensitive data \(found "secret"\)`
+	logger.Info("user found", zap.String("name", u.Password))  // want `log field "name" value u.Password may contain sensitive data`
+	sugar.Infof("password %s, pin %d", password, pin)          // want `may contain sensitive data \(found "password"\)`
+	slog.Info("login attempt", "password", redact.String(password))
+}
@@ -0,0 +1,11 @@
+package rules
+
+import "log/slog"
+
+func Examples() {
+	// english-only отключено
+	slog.Info("запуск сервера")
+	slog.Info("Starting server")     // want "^log message should start"
+	slog.Warn("server started!")     // want "^warning: log message contains disallowed symbol"
+	slog.Info("user", "password", 1) // want "^info: log message may contain sensitive data"
+}
@@ -0,0 +1,26 @@
+package safekeys
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func GoodExamples(n int) {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("passenger boarded", "token_count", n)
+	slog.Info("discard stale entries", "passengers", n)
+	logger.Info("request accepted", zap.Int64("tokenCount", 1), zap.String("csrf_token", ""))
+}
+
+func BadExamples(token string) {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("user logged in", "authToken", token)             // want `may contain sensitive data \(found "token"\)`
+	logger.Info("card declined", zap.String("card_number", "")) // want `may contain sensitive data \(found "card"\)` `may contain sensitive data \(found "card"\)`
+	slog.Info("keys rotated", "api_keys", 2)                    // want `may contain sensitive data \(found "api_key"\): "api_keys"`
+	slog.Info("session refreshed", "access_tokens", 2)          // want `may contain sensitive data \(found "token"\): "access_tokens"`
+	slog.Info("vault synced", "secrets", 3)                     // want `may contain sensitive data \(found "secret"\): "secrets"`
+	slog.Info("users imported", "passwords", 3)                 // want `may contain sensitive data \(found "password"\): "passwords"`
+}
@@ -0,0 +1,29 @@
+package secrets
+
+import "log/slog"
+
+// APIKey помечен чувствительным в настройке sensitive-types
+type APIKey string
+
+type Credentials struct {
+	User     string
+	Password string `json:"password" log:"sensitive"`
+}
+
+type Account struct {
+	ID    int
+	Creds *Credentials
+}
+
+// Token сам скрывает значение в логе
+type Token struct {
+	Value string `log:"redact"`
+}
+
+func (t Token) LogValue() slog.Value { return slog.StringValue("***") }
+
+// Node — рекурсивный тип без чувствительных полей
+type Node struct {
+	Name string
+	Next *Node
+}
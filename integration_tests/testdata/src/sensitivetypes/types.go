@@ -0,0 +1,44 @@
+package sensitivetypes
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/zap"
+
+	"sensitivetypes/secrets"
+)
+
+type Session struct { // want Session:"sensitiveType\\(field=Account.Creds.Password\\)"
+	ID      string
+	Account secrets.Account
+}
+
+type Profile struct { // want Profile:"sensitiveType\\(field=Phone\\)"
+	Name  string
+	Phone string `log:"redact"`
+}
+
+func GoodExamples(creds secrets.Credentials, t secrets.Token, node *secrets.Node, key secrets.APIKey) {
+	logger, _ := zap.NewProduction()
+
+	logger.Info("user found", zap.String("user", creds.User))
+	slog.Info("session issued", "value", t)
+	slog.Info("node visited", "node", node)
+	logger.Sugar().Infof("user %s has %d keys", creds.User, len(key))
+}
+
+func BadExamples(creds secrets.Credentials, accounts []secrets.Account, s *Session, p Profile, key secrets.APIKey) {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+	var zl zerolog.Logger
+
+	logger.Info("user found", zap.Any("user", creds))             // want `log field "user" value creds of type secrets.Credentials contains sensitive field Password`
+	logger.Info("accounts loaded", zap.Reflect("list", accounts)) // want `log field "list" value accounts of type \[\]secrets.Account contains sensitive field Creds.Password`
+	slog.Info("session restored", "session", s)                   // want `log field "session" value s of type \*Session contains sensitive field Account.Creds.Password`
+	slog.Info("profile updated", slog.Any("profile", p))          // want `log field "profile" value p of type Profile contains sensitive field Phone`
+	zl.Info().Interface("key", key).Msg("key loaded")             // want `log field "key" value key has sensitive type secrets.APIKey`
+	sugar.Infof("user %+v logged in", creds)                      // want `log message value creds of type secrets.Credentials contains sensitive field Password`
+	slog.Info(fmt.Sprintf("user %v logged in", creds))            // want `log message value creds of type secrets.Credentials contains sensitive field Password` "built dynamically"
+}
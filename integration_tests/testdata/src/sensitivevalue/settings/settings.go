@@ -0,0 +1,10 @@
+package settings
+
+import "sensitivevalue/settings/vault"
+
+// Settings содержит поле типа из пакета, который sensitivevalue не импортирует напрямую
+type Settings struct {
+	APIKey vault.Secret
+}
+
+func Load() Settings { return Settings{} }
@@ -0,0 +1,5 @@
+package vault
+
+type Secret struct{ value string }
+
+func (s Secret) String() string { return s.value }
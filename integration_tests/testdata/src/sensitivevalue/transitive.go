@@ -0,0 +1,13 @@
+package sensitivevalue
+
+import (
+	"log/slog"
+
+	"sensitivevalue/settings"
+)
+
+func TransitiveExamples() {
+	s := settings.Load()
+	key := s.APIKey.String()
+	slog.Info("client configured", "auth", key) // want `log field "auth" value key may contain sensitive data \(found "api_key" in APIKey\)`
+}
@@ -0,0 +1,66 @@
+package sensitivevalue
+
+import (
+	"crypto/sha256"
+	"errors"
+	"log/slog"
+
+	"github.com/rs/zerolog"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type User struct {
+	Name     string
+	Password string
+}
+
+type Config struct {
+	Host       string
+	DBPassword string
+}
+
+type Credentials interface {
+	Token() string
+}
+
+var errWrongPassword = errors.New("wrong password")
+
+func GoodExamples(u User, cfg *Config) {
+	logger, _ := zap.NewProduction()
+
+	passwordLen := len(u.Password)
+	hasToken := u.Password != ""
+	digest := sha256.Sum256([]byte(u.Password))
+	host := cfg.Host
+
+	logger.Info("user found", zap.String("name", u.Name), zap.Int("length", passwordLen))
+	slog.Info("user found", "authenticated", hasToken, "digest", digest[:4])
+	slog.Info("connecting", "host", host)
+	slog.Error("login failed", "error", errWrongPassword)
+}
+
+func BadExamples(u User, cfg *Config, creds Credentials, admin bool) {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+	var zl zerolog.Logger
+
+	logger.Info("user found", zap.String("name", u.Password)) // want `log field "name" value u.Password may contain sensitive data \(found "password"\)`
+
+	p := cfg.DBPassword
+	slog.Info("connecting", "dsn", p) // want `log field "dsn" value p may contain sensitive data \(found "password" in DBPassword\)`
+
+	t := creds.Token()
+	sugar.Infof("calling api as %s", t) // want `log message value t may contain sensitive data \(found "token" in Token\)`
+
+	v := u.Name
+	if admin {
+		v = u.Password
+	}
+	logrus.WithFields(logrus.Fields{"user": v}).Info("user found") // want `log field "user" value v may contain sensitive data \(found "password" in Password\)`
+
+	show := func() {
+		zl.Info().Str("value", p).Msg("connecting") // want `log field "value" value p may contain sensitive data \(found "password" in DBPassword\)`
+	}
+	show()
+}
@@ -19,8 +19,8 @@ func BadExamples() {
 	slog.Warn("server started! 🚀")     // want "contains disallowed symbol or emoji"
 	slog.Error("connection failed!!!") // want "contains disallowed symbol or emoji"
 
-	slog.Info("user password: " + password) // want "may contain sensitive data"
-	slog.Debug("api_key=" + apiKey)         // want "may contain sensitive data"
+	slog.Info("user password: " + password) // want "may contain sensitive data" "built dynamically"
+	slog.Debug("api_key=" + apiKey)         // want "may contain sensitive data" "contains disallowed symbol or emoji" "built dynamically"
 
 	logger, _ := zap.NewProduction()
 	defer logger.Sync()
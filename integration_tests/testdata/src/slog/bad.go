@@ -1,6 +1,7 @@
 package simple
 
 import (
+	"context"
 	"log/slog"
 )
 
@@ -13,10 +14,45 @@ func BadExamples() {
 	slog.Info("Starting server on port 8080") // want "log message should start"
 	slog.Error("запуск сервера")              // want "should contain only English"
 	slog.Warn("server started! 🚀")            // want "contains disallowed symbol or emoji"
-	slog.Info("user password: " + password)   // want "may contain sensitive data"
+	slog.Info("user password: " + password)   // want "may contain sensitive data" "built dynamically"
 
 	logger := slog.New(nil)
 	logger.Info("Starting instance")  // want "log message should start"
-	logger.Debug("api_key=" + apiKey) // want "may contain sensitive data"
+	logger.Debug("api_key=" + apiKey) // want "may contain sensitive data" "contains disallowed symbol or emoji" "built dynamically"
 	logger.Info("ok!")                // want "contains disallowed symbol or emoji"
 }
+
+func BadAttrExamples(ctx context.Context) {
+	slog.Info("user logged in", "password", password)                         // want "may contain sensitive data"
+	slog.Warn("request failed", "user", "bob", "api_key", apiKey)             // want "may contain sensitive data"
+	slog.Error("auth failed", slog.String("token", "t"))                      // want "may contain sensitive data"
+	slog.Debug("auth failed", slog.Group("auth", "user", "bob", "secret", 1)) // want `may contain sensitive data \(found "secret"\): "auth.secret"`
+
+	logger := slog.New(nil)
+	logger.Info("login", slog.Group("request", slog.Group("auth", slog.Any("password", password)))) // want `"request.auth.password"`
+	logger.LogAttrs(ctx, slog.LevelInfo, "Request completed", slog.Int("status", 200))              // want "log message should start"
+	logger.LogAttrs(ctx, slog.LevelInfo, "request completed", slog.String("api_key", apiKey))       // want "may contain sensitive data"
+	slog.LogAttrs(ctx, slog.LevelWarn, "login", slog.Group("user", slog.String("pass", "p")))       // want `"user.pass"`
+}
+
+func BadWithExamples() {
+	logger := slog.With("password", password)           // want "may contain sensitive data"
+	logger = logger.With(slog.String("token", "t"))     // want "may contain sensitive data"
+	logger = logger.WithGroup("secret")                 // want "may contain sensitive data"
+	logger.With("api_key", apiKey).Info("request sent") // want "may contain sensitive data"
+}
+
+func BadContextExamples(ctx context.Context) {
+	slog.InfoContext(ctx, "Starting server")           // want "log message should start"
+	slog.ErrorContext(ctx, "ошибка", "user", "bob")    // want "should contain only English"
+	slog.WarnContext(ctx, "ok", "password", password)  // want "may contain sensitive data"
+	slog.DebugContext(ctx, "done!")                    // want "contains disallowed symbol or emoji"
+	slog.Log(ctx, slog.LevelWarn, "Disk almost full")  // want "log message should start"
+	slog.Log(ctx, slog.LevelInfo, "login", "token", 1) // want "may contain sensitive data"
+
+	logger := slog.New(nil)
+	logger.InfoContext(ctx, "Request started")                 // want "log message should start"
+	logger.ErrorContext(ctx, "request failed", "api_key", "k") // want "may contain sensitive data"
+	logger.Log(ctx, slog.LevelError, "request failed!!")       // want "contains disallowed symbol or emoji"
+	logger.LogAttrs(ctx, slog.LevelDebug, "Cache miss")        // want "log message should start"
+}
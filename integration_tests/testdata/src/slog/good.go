@@ -1,6 +1,7 @@
 package simple
 
 import (
+	"context"
 	"log/slog"
 )
 
@@ -13,4 +14,34 @@ func GoodExamples() {
 	logger := slog.New(nil)
 	logger.Info("starting instance")
 	logger.Debug("operation completed")
+	// выражения методов не проверяются: получатель идёт первым аргументом
+	(*slog.Logger).Info(logger, "method expr")
+}
+
+func GoodAttrExamples(ctx context.Context) {
+	slog.Info("user logged in", "user", "alice", "attempt", 1)
+	slog.Error("request failed", slog.String("path", "/api"), "status", 500)
+	slog.Debug("request", slog.Group("http", "method", "GET", slog.Int("status", 200)))
+	// значения пар не проверяются как ключи
+	slog.Warn("validation failed", "field", "password")
+
+	logger := slog.New(nil)
+	logger.LogAttrs(ctx, slog.LevelInfo, "request completed", slog.Int("status", 200))
+}
+
+func GoodWithExamples() {
+	logger := slog.With("user", "alice")
+	logger = logger.With(slog.String("request_id", "r1"))
+	logger = logger.WithGroup("http")
+	logger.Info("request sent")
+}
+
+func GoodContextExamples(ctx context.Context) {
+	slog.InfoContext(ctx, "starting server", "port", 8080)
+	slog.Log(ctx, slog.LevelWarn, "disk almost full", "free", 10)
+
+	logger := slog.New(nil)
+	logger.DebugContext(ctx, "cache miss", slog.String("key", "user"))
+	logger.Log(ctx, slog.LevelError, "request failed", "status", 500)
+	logger.LogAttrs(ctx, slog.LevelInfo, "request completed", slog.Group("http", slog.Int("status", 200)))
 }
@@ -0,0 +1,50 @@
+package structured
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type user struct {
+	ID   int
+	Name string
+}
+
+const prefix = "request "
+
+func GoodExamples(ctx context.Context, id string) {
+	logger, _ := zap.NewProduction()
+
+	slog.Info("user logged in", "id", id)
+	slog.Info(prefix + "completed")
+	logger.Info("user logged in", zap.String("id", id))
+	// logrus принимает поля только через WithField, правило к нему не применяется
+	logrus.Info("user " + id + " logged in")
+}
+
+func BadExamples(ctx context.Context, userID string, u user, took time.Duration, err error, args []any) {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+
+	slog.Info("user " + userID + " logged in")                       // want "log message is built dynamically"
+	slog.Error("failed to connect: " + err.Error())                  // want "log message is built dynamically"
+	slog.InfoContext(ctx, fmt.Sprintf("request took %s", took))      // want "log message is built dynamically"
+	slog.Warn(fmt.Sprintf("user %s has %d sessions", u.Name, u.ID))  // want "log message is built dynamically"
+	slog.Debug("Retry " + userID + " of " + userID)                  // want "log message is built dynamically" "log message should start"
+	slog.Info(fmt.Sprintf("user %[1]s", userID))                     // want "log message is built dynamically"
+	slog.Info("user "+userID, args...)                               // want "log message is built dynamically"
+	logger.Info("request took " + took.String())                     // want "log message is built dynamically"
+	logger.Error(fmt.Sprintf("request %s failed: %v", userID, err))  // want "log message is built dynamically"
+	logger.Warn("slow request: "+fmt.Sprint(took), zap.Int("id", 1)) // want "log message is built dynamically"
+	sugar.Infow("user " + u.Name + " logged in")                     // want "log message is built dynamically"
+	sugar.Infof("user %s logged in", u.Name)
+	slog.Info(userID + errors.New("x").Error())                 // want "log message is built dynamically"
+	slog.Info(fmt.Sprintf("%d items", u.ID) + " done")          // want "log message is built dynamically"
+	slog.Info("user " + fmt.Sprintf("%[1]s", userID) + " done") // want "log message is built dynamically"
+}
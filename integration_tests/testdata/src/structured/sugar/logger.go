@@ -0,0 +1,8 @@
+package sugar
+
+import "go.uber.org/zap"
+
+func newSugar() *zap.SugaredLogger {
+	logger, _ := zap.NewProduction()
+	return logger.Sugar()
+}
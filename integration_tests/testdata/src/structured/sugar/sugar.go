@@ -0,0 +1,7 @@
+package sugar
+
+// Bad — пакет zap не импортирован в файле, поэтому поля передаются парами ключ/значение.
+func Bad(userID string) {
+	sugar := newSugar()
+	sugar.Infow("user " + userID + " logged in") // want "log message is built dynamically"
+}
@@ -0,0 +1,32 @@
+package wrappers
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+	"wrappers/logutil"
+)
+
+// logDebug — обёртка в тестируемом пакете
+func logDebug(msg string) { // want logDebug:"logWrapper\\(message=0, printf=false, fields=none\\)"
+	slog.Debug(msg)
+}
+
+// logDebugTwice вызывает обёртку того же пакета
+func logDebugTwice(prefix, msg string) { // want logDebugTwice:"logWrapper\\(message=1, printf=false, fields=none\\)"
+	logDebug(msg)
+	logDebug(msg)
+}
+
+func BadExamples(ctx context.Context) {
+	logutil.LogErr("Failed to connect")                // want "log message should start"
+	logutil.LogCtx(ctx, "запуск сервера")              // want "should contain only English"
+	logutil.Infof("Request took a while")              // want "log message should start"
+	logutil.Infof("request took %d ms", "slow")        // want `log format %d has arg "slow" of wrong type string`
+	logutil.Warn("disk full", zap.String("token", "")) // want "may contain sensitive data"
+	logutil.ZapWarn("connection failed!!!")            // want "contains disallowed symbol or emoji"
+
+	logDebug("Cache miss")                 // want "log message should start"
+	logDebugTwice("cache", "cache miss!!") // want "contains disallowed symbol or emoji"
+}
@@ -0,0 +1,20 @@
+package wrappers
+
+import (
+	"context"
+
+	"wrappers/logutil"
+)
+
+// notWrapper не пробрасывает параметр в логер
+func notWrapper(msg string) string {
+	return msg
+}
+
+func GoodExamples(ctx context.Context) {
+	logutil.LogErr("failed to connect", "user", "alice")
+	logutil.LogCtx(ctx, "server started")
+	logutil.Audit("Alice")
+	notWrapper("Not a log message!")
+	logDebug("cache hit")
+}
@@ -0,0 +1,40 @@
+package logutil
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+var logger, _ = zap.NewProduction()
+
+// LogErr пробрасывает сообщение и поля в slog.
+func LogErr(msg string, args ...any) {
+	slog.Error(msg, args...)
+}
+
+// LogCtx принимает сообщение вторым аргументом.
+func LogCtx(ctx context.Context, msg string) {
+	slog.Info(msg)
+}
+
+// Infof пробрасывает строку формата в SugaredLogger.
+func Infof(format string, args ...interface{}) {
+	logger.Sugar().Infof(format, args...)
+}
+
+// Warn вызывает другую обёртку.
+func Warn(msg string, fields ...interface{}) {
+	ZapWarn(msg, fields...)
+}
+
+// ZapWarn пробрасывает сообщение и поля в zap.
+func ZapWarn(msg string, fields ...interface{}) {
+	logger.Warn(msg, fields...)
+}
+
+// Audit логирует фиксированное сообщение и не является обёрткой.
+func Audit(user string) {
+	slog.Info("audit event")
+}
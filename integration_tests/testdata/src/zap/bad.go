@@ -20,8 +20,8 @@ func BadExamples() {
 	logger.Debug("service started! 🚀")  // want "contains disallowed symbol or emoji"
 	logger.Warn("connection failed!!!") // want "contains disallowed symbol or emoji"
 
-	logger.Info("user password: " + password) // want "may contain sensitive data"
-	logger.Info("token: " + token)            // want "may contain sensitive data"
+	logger.Info("user password: " + password) // want "may contain sensitive data" "built dynamically"
+	logger.Info("token: " + token)            // want "may contain sensitive data" "built dynamically"
 
 	logger.Info("hello", zap.String("token", "12345"))  // want "may contain sensitive data"
 	logger.Info("hello", zap.Int64("token", 12345))     // want "may contain sensitive data"
@@ -30,3 +30,31 @@ func BadExamples() {
 
 	logger.Info("info", zap.String("user", "bob"), zap.String("api_key", apiKey), zap.Int64("count", 1)) // want "may contain sensitive data"
 }
+
+func BadSugaredExamples() {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+
+	sugar.Info("Failed to start service")  // want "log message should start"
+	sugar.Infof("Request took %d ms", 10)  // want "log message should start"
+	sugar.Errorln("ошибка подключения")    // want "should contain only English"
+	sugar.Warnw("connection failed!!!")    // want "contains disallowed symbol or emoji"
+	sugar.Debugw("token: "+token, "id", 1) // want "may contain sensitive data" "built dynamically"
+
+	sugar.Infow("user logged in", "password", password)                           // want "may contain sensitive data"
+	sugar.Errorw("request failed", "user", "bob", "api_key", apiKey)              // want "may contain sensitive data"
+	sugar.Fatalw("shutting down", zap.String("token", token), "reason", "signal") // want "may contain sensitive data"
+	sugar.DPanicw("unexpected state", "secret", 1)                                // want "may contain sensitive data"
+}
+
+func BadWithExamples() {
+	logger, _ := zap.NewProduction()
+
+	logger = logger.With(zap.String("api_key", apiKey))                                  // want "may contain sensitive data"
+	logger = logger.WithOptions(zap.AddCaller(), zap.Fields(zap.String("token", token))) // want "may contain sensitive data"
+	logger = logger.Named("password-checker")                                            // want "may contain sensitive data"
+	logger.With(zap.Int64("user", 1), zap.Any("secret", nil)).Info("user loaded")        // want "may contain sensitive data"
+
+	sugar := logger.Sugar().With("password", password) // want "may contain sensitive data"
+	sugar.Named("token-refresher").Info("refreshed")   // want "may contain sensitive data"
+}
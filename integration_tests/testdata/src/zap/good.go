@@ -16,6 +16,28 @@ func GoodExamples() {
 	logger.Debug("payload", zap.Any("data", map[string]interface{}{"ok": true}))
 
 	logger.Info("batch processed", zap.String("batch_id", "b1"), zap.Int64("items", 100), zap.String("source", "svc"))
-	
+
 	logger.Info("misc", zap.String("note", "all good"), zap.Int64("duration_ms", 120))
 }
+
+func GoodSugaredExamples() {
+	logger, _ := zap.NewProduction()
+	sugar := logger.Sugar()
+
+	sugar.Info("starting service")
+	sugar.Infof("request completed")
+	sugar.Infoln("service stopped")
+	sugar.Infow("user logged in", "user", "alice", "attempt", 1)
+	sugar.Errorw("request failed", zap.String("path", "/api"), "status", 500)
+	// значения пар не проверяются как ключи
+	sugar.Debugw("cache hit", "key", "password")
+}
+
+func GoodWithExamples() {
+	logger, _ := zap.NewProduction()
+
+	logger = logger.With(zap.String("request_id", "r1"))
+	logger = logger.WithOptions(zap.AddCaller(), zap.Fields(zap.String("service", "api")))
+	logger = logger.Named("http")
+	logger.Sugar().With("user", "alice").Info("user loaded")
+}
@@ -0,0 +1,31 @@
+package simple
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	password = "secret"
+	token    = "tok"
+)
+
+func BadExamples() {
+	log.Info().Msg("Starting server on port 8080")    // want "log message should start"
+	log.Error().Msg("ошибка подключения")             // want "should contain only English"
+	log.Warn().Msg("server started! 🚀")               // want "contains disallowed symbol or emoji"
+	log.Debug().Msg("user password: " + password)     // want "may contain sensitive data"
+	log.Info().Msgf("Request took %d ms", 10)         // want "log message should start"
+	log.Info().Str("token", token).Msg("started")     // want "may contain sensitive data"
+	log.Info().Int("id", 1).Any("secret", nil).Send() // want "may contain sensitive data"
+
+	logger := zerolog.New()
+	logger.Info().Interface("password", password).Msg("user created")                  // want "may contain sensitive data"
+	logger.Error().Dict("auth", zerolog.Dict().Str("api_key", "k")).Msg("auth failed") // want `may contain sensitive data \(found "api_key"\): "auth.api_key"`
+}
+
+func BadWithExamples() {
+	logger := log.With().Str("token", token).Logger()                     // want "may contain sensitive data"
+	logger = logger.With().Timestamp().Str("password", password).Logger() // want "may contain sensitive data"
+	logger.Info().Msg("ready")
+}
@@ -0,0 +1,25 @@
+package simple
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func GoodExamples() {
+	log.Info().Msg("starting server on port 8080")
+	log.Error().Err(errors.New("boom")).Msg("failed to connect to database")
+	log.Debug().Str("user", "alice").Int("attempt", 1).Msg("user logged in")
+	log.Info().Timestamp().Send()
+
+	logger := zerolog.New()
+	logger.Info().Dict("request", zerolog.Dict().Str("path", "/api")).Msg("request completed")
+	// значения полей не проверяются как ключи
+	logger.Warn().Str("field", "password").Msg("validation failed")
+}
+
+func GoodWithExamples() {
+	logger := log.With().Str("service", "api").Timestamp().Logger()
+	logger.Info().Msg("ready")
+}
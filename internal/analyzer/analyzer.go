@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
-	"go/types"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -12,156 +12,353 @@ import (
 )
 
 type Config struct {
-	AllowedPunctuation      string   `yaml:"allowed-punctuation"`
-	CustomSensitivePatterns []string `yaml:"custom-sensitive-patterns"`
-	IgnoreZapFields         bool     `yaml:"ignore-zap-fields"`
+	AllowedPunctuation      string                `yaml:"allowed-punctuation"`
+	CustomSensitivePatterns []string              `yaml:"custom-sensitive-patterns"`
+	EntropyThreshold        float64               `yaml:"entropy-threshold"`
+	IgnoreZapFields         bool                  `yaml:"ignore-zap-fields"`
+	KeyStyle                string                `yaml:"key-style"`
+	Loggers                 []LoggerConfig        `yaml:"loggers"`
+	PII                     PIIConfig             `yaml:"pii"`
+	Redactor                string                `yaml:"redactor"`
+	Rules                   map[string]RuleConfig `yaml:"rules"`
+	SafeKeys                []string              `yaml:"safe-keys"`
+	SensitivePatterns       []SensitivePattern    `yaml:"sensitive-patterns"`
+	SensitiveTypes          []string              `yaml:"sensitive-types"`
+	ReportUnusedDirectives  bool                  `yaml:"report-unused-directives"`
+	RequireDirectiveReason  bool                  `yaml:"require-directive-reason"`
+
+	keyStyle  *regexp.Regexp     // скомпилированный KeyStyle
+	patterns  []sensitivePattern // скомпилированные CustomSensitivePatterns и SensitivePatterns
+	safeWords [][]string         // SafeKeys и встроенные безопасные термины, разбитые на слова
+}
+
+// DefaultConfig возвращает конфигурацию по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		AllowedPunctuation:      ",-/:()",
+		CustomSensitivePatterns: []string{},
+		EntropyThreshold:        4.0,
+		IgnoreZapFields:         false,
+		PII:                     PIIConfig{Email: true, IP: true, Phone: true, Card: true},
+	}
 }
 
-func NewAnalyzer(cfg Config) *analysis.Analyzer {
+// NewAnalyzer создаёт анализатор с указанными настройками. Регулярные выражения настроек
+// компилируются один раз; некорректные настройки возвращаются как ошибка.
+func NewAnalyzer(cfg Config) (*analysis.Analyzer, error) {
+	if err := cfg.prepare(); err != nil {
+		return nil, err
+	}
 	return &analysis.Analyzer{
-		Name: "prettyloglint",
-		Doc:  "checks log messages for compliance with rules",
+		Name:      "prettyloglint",
+		Doc:       "checks log messages for compliance with rules",
+		FactTypes: []analysis.Fact{new(wrapperFact), new(sensitiveFact)},
 		Run: func(pass *analysis.Pass) (interface{}, error) {
 			return run(pass, cfg)
 		},
-	}
+	}, nil
 }
 
 func run(pass *analysis.Pass, cfg Config) (interface{}, error) {
+	// диагностики, подавленные директивами //prettyloglint:ignore, не отправляются
+	directives := parseDirectives(pass)
+	origPass := pass
+	filtered := *pass
+	filtered.Report = func(d analysis.Diagnostic) {
+		if !suppress(pass.Fset, directives, d) {
+			origPass.Report(d)
+		}
+	}
+	pass = &filtered
+
+	loggers := buildLoggerIndex(cfg)
+	exportWrapperFacts(pass, loggers)
+	tracer := newValueTracer(pass)
+	sens := newSensitiveTypes(pass, cfg)
+	sens.exportFacts()
 	for _, file := range pass.Files {
+		// поля, привязанные через With, отслеживаются в пределах функции
+		var bound *boundFields
+		var stack []ast.Node
 		ast.Inspect(file, func(n ast.Node) bool {
+			if n == nil {
+				// присваивание учитывается после проверки вызовов в его правой части:
+				// l = l.With(...) проверяется с полями, привязанными к l раньше
+				bound.record(stack[len(stack)-1])
+				stack = stack[:len(stack)-1]
+				return true
+			}
+			stack = append(stack, n)
+			if _, ok := n.(*ast.FuncDecl); ok {
+				bound = newBoundFields(pass, loggers)
+			}
 			callExpr, ok := n.(*ast.CallExpr)
 			if !ok {
 				return true
 			}
 
-			if !isLoggingCall(pass, callExpr) {
+			logger, ok := loggers.lookup(pass, callExpr)
+			if !ok {
 				return true
 			}
 
-			processCall(pass, callExpr, cfg)
+			processCall(pass, callExpr, logger, bound, tracer, sens, cfg)
 
 			return true
 		})
 	}
+	reportDirectiveProblems(origPass, directives, cfg)
 	return nil, nil
 }
 
-// extractMessageFromExpr пытается получить строковую литералу из выражения.
-// Возвращает текст сообщения, найденный *ast.BasicLit (если есть) и true, если удачно.
+// messageSegment — постоянная часть сообщения: литерал, константа или строка формата fmt.Sprintf
+type messageSegment struct {
+	text   string
+	lit    *ast.BasicLit // литерал для исправления; nil, если текст нельзя исправить на месте
+	format bool          // текст является строкой формата: директивы не входят в сообщение
+}
+
+// extractMessageFromExpr пытается получить постоянные части сообщения из выражения.
+// Возвращает части в порядке следования и true, если найдена хотя бы одна.
 // Поддерживается:
 // - "literal"
-// - concatenation: "a" + var, "a" + "b"
+// - concatenation: "a" + var, "a" + "b", fmt.Sprintf("%d items", n) + " done"
 // - fmt.Sprintf-like вызов: Sprintf("format %s", ...)
-func extractMessageFromExpr(expr ast.Expr) (string, *ast.BasicLit, bool) {
+// - именованные строковые константы, в том числе из других пакетов: msgServerStarted, msgs.Started
+func extractMessageFromExpr(pass *analysis.Pass, expr ast.Expr) ([]messageSegment, bool) {
 	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return extractMessageFromExpr(pass, e.X)
+	case *ast.Ident, *ast.SelectorExpr:
+		text, bl, ok := constantMessage(pass, e)
+		if !ok {
+			return nil, false
+		}
+		return []messageSegment{{text: text, lit: bl}}, true
 	case *ast.BasicLit:
-		if e.Kind == token.STRING {
-			s, err := strconv.Unquote(e.Value)
-			if err != nil {
-				s = strings.Trim(e.Value, "\"`")
-			}
-			return s, e, true
+		if text, ok := stringLiteralValue(e); ok {
+			return []messageSegment{{text: text, lit: e}}, true
 		}
-		return "", nil, false
+		return nil, false
 	case *ast.BinaryExpr:
 		if e.Op == token.ADD {
-			left, lbl, lok := extractMessageFromExpr(e.X)
-			right, rbl, rok := extractMessageFromExpr(e.Y)
-			if lok && rok {
-				// в случае двух литералов выбираем левый как целевой для фикса
-				return left + right, lbl, true
-			}
-			if lok {
-				return left, lbl, true
-			}
-			if rok {
-				return right, rbl, true
-			}
+			left, lok := extractMessageFromExpr(pass, e.X)
+			right, rok := extractMessageFromExpr(pass, e.Y)
+			return append(left, right...), lok || rok
 		}
-		return "", nil, false
+		return nil, false
 	case *ast.CallExpr:
+		// преобразование типа: string(msgTyped)
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return extractMessageFromExpr(pass, e.Args[0])
+		}
 		if len(e.Args) > 0 {
-			if bl, ok := e.Args[0].(*ast.BasicLit); ok && bl.Kind == token.STRING {
-				s, err := strconv.Unquote(bl.Value)
-				if err != nil {
-					s = strings.Trim(bl.Value, "\"`")
+			if bl, ok := e.Args[0].(*ast.BasicLit); ok {
+				if text, ok := stringLiteralValue(bl); ok {
+					_, sprintf := sprintfCall(pass, e)
+					return []messageSegment{{text: text, lit: bl, format: sprintf}}, true
 				}
-				return s, bl, true
 			}
 		}
-		return "", nil, false
+		return nil, false
 	default:
-		return "", nil, false
+		return nil, false
 	}
 }
 
-func processCall(pass *analysis.Pass, callExpr *ast.CallExpr, cfg Config) {
-	if len(callExpr.Args) == 0 {
-		return
+// segmentsText собирает текст сообщения из частей; из строк формата удаляются директивы.
+// Если plainOnly, строки формата пропускаются.
+func segmentsText(segments []messageSegment, plainOnly bool) string {
+	var b strings.Builder
+	for _, s := range segments {
+		switch {
+		case !s.format:
+			b.WriteString(s.text)
+		case !plainOnly:
+			b.WriteString(stripFormatVerbs(s.text))
+		}
 	}
-	msg, bl, ok := extractMessageFromExpr(callExpr.Args[0])
-	if !ok {
+	return b.String()
+}
+
+func processCall(pass *analysis.Pass, callExpr *ast.CallExpr, logger *LoggerConfig, bound *boundFields, tracer *valueTracer, sens *sensitiveTypes, cfg Config) {
+	if logger.MessageIndex >= 0 && logger.MessageIndex < len(callExpr.Args) {
+		msgExpr := callExpr.Args[logger.MessageIndex]
+		var text string
+		// переписывание вызова в сообщение с полями включает и исправление стиля сообщения
+		structuredFix, rewritten := checkStructuredMessage(pass, callExpr, logger, cfg)
+		segments, ok := extractMessageFromExpr(pass, msgExpr)
+		if !ok {
+			var msg string
+			var bl *ast.BasicLit
+			if msg, bl, ok = packageVarMessage(pass, msgExpr); ok {
+				segments = []messageSegment{{text: msg, lit: bl}}
+			}
+		}
+		if ok {
+			// сообщение метода с форматированием целиком является строкой формата,
+			// строки формата fmt.Sprintf (в том числе внутри конкатенации) проверяются без директив
+			if logger.Printf {
+				for i := range segments {
+					segments[i].format = true
+				}
+			}
+			text = segmentsText(segments, false)
+			// если в постоянном тексте метода без форматирования есть директива, сообщение нужно
+			// переписать вручную: удаление % из директивы его не исправит
+			manual := !logger.Printf && checkNonPrintfCall(pass, callExpr, segmentsText(segments, true), cfg)
+
+			var fixes []analysis.SuggestedFix
+			if rewritten {
+				fixes = []analysis.SuggestedFix{structuredFix}
+			} else if !manual {
+				if fix, ok := createMessageFix(segments, text, cfg); ok {
+					fixes = []analysis.SuggestedFix{fix}
+				}
+			}
+			// значения сообщения, которые можно обернуть функцией redactor
+			var values []ast.Expr
+			if parts, _, _ := dynamicMessageParts(pass, msgExpr); len(parts) > 0 {
+				for _, part := range parts {
+					if part.value != nil {
+						values = append(values, part.value)
+					}
+				}
+			}
+			if logger.Printf {
+				values = append(values, callExpr.Args[logger.MessageIndex+1:]...)
+			}
+			checkMessage(pass, callExpr, text, fixes, values, cfg)
+		}
+		if logger.Printf {
+			checkPrintfCall(pass, callExpr, logger, cfg)
+		}
+		checkMessageValues(pass, tracer, sens, callExpr, logger, text, cfg)
+	}
+	if logger.Package == zapPackage && cfg.IgnoreZapFields {
 		return
 	}
-	checkMessage(pass, callExpr, msg, bl, cfg)
-	if isZapCall(pass, callExpr) && !cfg.IgnoreZapFields {
-		checkZapFields(pass, callExpr, cfg)
+	checkKeyValuePairs(pass, callExpr, logger, cfg)
+	keys := collectFields(pass, callExpr, logger)
+	for _, key := range keys {
+		// проверяем ключ на чувствительные слова
+		checkSensitiveKeyLiteral(pass, key, cfg)
+		if value, ok := fieldStringValue(pass, key); ok {
+			checkSecretValue(pass, key, value, cfg)
+			checkPIIValue(pass, key, value, cfg)
+			checkPatternValue(pass, key, value, cfg)
+		}
+		checkFieldValue(pass, tracer, sens, key, cfg)
+		// имена логеров и групп (Named, WithGroup) ключами полей не являются
+		if logger.Fields != FieldsName {
+			checkKeyStyle(pass, key, cfg)
+		}
+	}
+	if logger.Fields != FieldsName {
+		checkDuplicateKeys(pass, callExpr, keys, bound, cfg)
 	}
 }
 
-func checkMessage(pass *analysis.Pass, callExpr *ast.CallExpr, message string, bl *ast.BasicLit, cfg Config) {
+// checkMessage проверяет сообщение всеми правилами. Каждое нарушение сообщается отдельно,
+// а исправления всех правил объединены в одну правку (fixes), после которой
+// сообщение соответствует всем правилам сразу. Для строки формата message передаётся без директив.
+func checkMessage(pass *analysis.Pass, callExpr *ast.CallExpr, message string, fixes []analysis.SuggestedFix, values []ast.Expr, cfg Config) {
 	trimmed := strings.TrimSpace(message)
 	if trimmed == "" {
 		return
 	}
 
-	if ok, newMessage := checkStartWithLowercase(trimmed); ok {
-		if bl != nil {
-			fix := createReplaceLiteralFix(bl, newMessage, "make first letter lowercase")
-			pass.Report(analysis.Diagnostic{
-				Pos:            callExpr.Pos(),
-				End:            callExpr.End(),
-				Message:        fmt.Sprintf("log message should start with a lowercase letter: %q", trimmed),
-				SuggestedFixes: []analysis.SuggestedFix{fix},
-			})
-			return
-		}
-		pass.Reportf(callExpr.Pos(), "log message should start with a lowercase letter: %q", trimmed)
+	reportMessage := func(rule string, format string, args ...interface{}) {
+		report(pass, cfg, rule, analysis.Diagnostic{
+			Pos:            callExpr.Pos(),
+			End:            callExpr.End(),
+			Message:        fmt.Sprintf(format, args...),
+			SuggestedFixes: fixes,
+		})
 	}
 
-	if ok, newMessage := checkEnglishOnly(trimmed, cfg); ok {
-		if bl != nil {
-			fix := createReplaceLiteralFix(bl, newMessage, "remove non-Latin characters")
-			pass.Report(analysis.Diagnostic{
-				Pos:            callExpr.Pos(),
-				End:            callExpr.End(),
-				Message:        fmt.Sprintf("log message should contain only English letters (no non-Latin scripts): %q", trimmed),
-				SuggestedFixes: []analysis.SuggestedFix{fix},
-			})
-			return
-		}
-		pass.Reportf(callExpr.Pos(), "log message should contain only English letters (no non-Latin scripts): %q", trimmed)
+	if ok, _ := checkStartWithLowercase(trimmed); ok {
+		reportMessage(RuleLowercaseStart, "log message should start with a lowercase letter: %q", trimmed)
 	}
 
-	if ok, sensitive := checkSensitiveKeys(trimmed, cfg); ok {
-		pass.Reportf(callExpr.Pos(), "log message may contain sensitive data (found %q): %q", sensitive, trimmed)
-		return
+	if ok, _ := checkEnglishOnly(trimmed, cfg); ok {
+		reportMessage(RuleEnglishOnly, "log message should contain only English letters (no non-Latin scripts): %q", trimmed)
+	}
+
+	if ok, sensitive := checkSensitiveKeys(trimmed, targetMessage, cfg); ok {
+		report(pass, cfg, RuleSensitiveData, analysis.Diagnostic{
+			Pos:            callExpr.Pos(),
+			Message:        fmt.Sprintf("log message may contain sensitive data (found %q): %q", sensitive, trimmed),
+			SuggestedFixes: redactFix(pass, values, cfg),
+		})
+	}
+
+	if detector, ok := detectPII(trimmed, cfg); ok {
+		reportf(pass, cfg, RuleSensitiveData, callExpr.Pos(), "log message may contain personal data (%s): %q", detector, trimmed)
+	}
+
+	if detector, ok := detectSecret(trimmed, cfg); ok {
+		reportf(pass, cfg, RuleHardcodedSecret, callExpr.Pos(), "log message contains a hardcoded secret (%s)", detector)
 	}
 
 	if ok, symbol := checkDisallowedSymbols(trimmed, cfg); ok {
-		if bl != nil {
-			fix := createReplaceLiteralFix(bl, strings.ReplaceAll(trimmed, symbol, ""), "remove disallowed symbols")
-			pass.Report(analysis.Diagnostic{
-				Pos:            callExpr.Pos(),
-				End:            callExpr.End(),
-				Message:        fmt.Sprintf("log message contains disallowed symbol or emoji: %q", symbol),
-				SuggestedFixes: []analysis.SuggestedFix{fix},
-			})
-			return
+		reportMessage(RuleDisallowedSymbols, "log message contains disallowed symbol or emoji: %q", symbol)
+	}
+}
+
+// createMessageFix создаёт одну правку всех литералов сообщения, после которой сообщение соответствует
+// всем включённым правилам: "Done" + " Окей!" -> "done" + " ". Первая буква исправляется только
+// у первого литерала и только если он стоит в начале сообщения.
+func createMessageFix(segments []messageSegment, message string, cfg Config) (analysis.SuggestedFix, bool) {
+	var edits []analysis.TextEdit
+	seen := make(map[*ast.BasicLit]bool)
+	for i, seg := range segments {
+		// один литерал может попасть в сообщение несколько раз через константу: msg + msg
+		if seg.lit == nil || seen[seg.lit] {
+			continue
+		}
+		seen[seg.lit] = true
+		value, ok := stringLiteralValue(seg.lit)
+		if !ok {
+			continue
+		}
+		text := value
+		if seg.format {
+			text = stripFormatVerbs(value)
+		}
+		atStart := i == 0 && strings.HasPrefix(message, text)
+		fix := func(first bool, s string) string {
+			return fixMessageText(s, first && atStart, cfg)
 		}
-		pass.Reportf(callExpr.Pos(), "log message contains disallowed symbol or emoji: %q", symbol)
+		var fixed string
+		if seg.format {
+			fixed = fixFormatText(value, fix, true)
+		} else {
+			fixed = fix(true, value)
+		}
+		if fixed != value {
+			edits = append(edits, analysis.TextEdit{Pos: seg.lit.Pos(), End: seg.lit.End(), NewText: []byte(strconv.Quote(fixed))})
+		}
+	}
+	if len(edits) == 0 {
+		return analysis.SuggestedFix{}, false
+	}
+	return analysis.SuggestedFix{Message: "fix log message style", TextEdits: edits}, true
+}
+
+// fixMessageText исправляет текст сообщения по всем включённым правилам.
+// Первая буква исправляется, только если текст стоит в начале сообщения (atStart).
+func fixMessageText(text string, atStart bool, cfg Config) string {
+	if atStart && cfg.ruleEnabled(RuleLowercaseStart) {
+		text = fixStartWithLowercase(text)
 	}
+	if cfg.ruleEnabled(RuleEnglishOnly) {
+		text = removeNonLatinLetters(text)
+	}
+	if cfg.ruleEnabled(RuleDisallowedSymbols) {
+		text = removeDisallowedSymbols(text, cfg)
+	}
+	return text
 }
 
 func createReplaceLiteralFix(bl *ast.BasicLit, newContent string, message string) analysis.SuggestedFix {
@@ -178,102 +375,35 @@ var allowedPunctuation = map[rune]bool{
 	'(': true, ')': true,
 }
 
-var allowedLoggerPackages = map[string]struct{}{
-	"log/slog":        {},
-	"go.uber.org/zap": {},
-}
-
-func packagePathOfExpr(pass *analysis.Pass, expr ast.Expr) (string, bool) {
-	if ident, ok := expr.(*ast.Ident); ok {
-		if obj := pass.TypesInfo.Uses[ident]; obj != nil {
-			if pkgName, ok := obj.(*types.PkgName); ok {
-				return pkgName.Imported().Path(), true
-			}
-		}
+// stringLiteralValue возвращает значение строкового литерала
+func stringLiteralValue(expr ast.Expr) (string, bool) {
+	bl, ok := expr.(*ast.BasicLit)
+	if !ok || bl.Kind != token.STRING {
+		return "", false
 	}
-	if typ := pass.TypesInfo.TypeOf(expr); typ != nil {
-		if ptr, ok := typ.(*types.Pointer); ok {
-			typ = ptr.Elem()
-		}
-		if named, ok := typ.(*types.Named); ok {
-			if named.Obj() != nil && named.Obj().Pkg() != nil {
-				return named.Obj().Pkg().Path(), true
-			}
-		}
-	}
-	return "", false
-}
-
-func isLoggingCall(pass *analysis.Pass, callExpr *ast.CallExpr) bool {
-	if selExpr, ok := callExpr.Fun.(*ast.SelectorExpr); ok {
-		method := selExpr.Sel.Name
-		allowedMethods := map[string]bool{
-			"Info": true, "Infof": true, "Error": true, "Errorf": true,
-			"Warn": true, "Warnf": true, "Warning": true, "Debug": true, "Debugf": true,
-		}
-		if !allowedMethods[method] {
-			return false
-		}
-
-		if path, ok := packagePathOfExpr(pass, selExpr.X); ok {
-			if _, ok := allowedLoggerPackages[path]; ok {
-				return true
-			}
-		}
+	s, err := strconv.Unquote(bl.Value)
+	if err != nil {
+		s = strings.Trim(bl.Value, "\"`")
 	}
-	return false
+	return s, true
 }
 
-func isZapCall(pass *analysis.Pass, callExpr *ast.CallExpr) bool {
-	if selExpr, ok := callExpr.Fun.(*ast.SelectorExpr); ok {
-		if path, ok := packagePathOfExpr(pass, selExpr.X); ok {
-			return path == "go.uber.org/zap"
-		}
+func checkSensitiveKeyLiteral(pass *analysis.Pass, key fieldKey, cfg Config) {
+	if key.value != nil && isRedacted(pass, key.value, cfg) {
+		return
 	}
-	return false
-}
-
-// checkZapFields просматривает дополнительные аргументы zap (поля) и проверяет ключи на чувствительные слова
-func checkZapFields(pass *analysis.Pass, callExpr *ast.CallExpr, cfg Config) {
-	// пропускаем первый аргумент (сообщение)
-	for i := 1; i < len(callExpr.Args); i++ {
-		arg := callExpr.Args[i]
-		// ожидание: аргумент — вызов функции-помощника zap.String/Any/Int64 и т.п.
-		if innerCall, ok := arg.(*ast.CallExpr); ok {
-			if sel, ok := innerCall.Fun.(*ast.SelectorExpr); ok {
-				// проверяем, что это функция из пакета zap
-				if ident, ok := sel.X.(*ast.Ident); ok {
-					if obj := pass.TypesInfo.Uses[ident]; obj != nil {
-						if pkgName, ok := obj.(*types.PkgName); ok {
-							if pkgName.Imported().Path() == "go.uber.org/zap" {
-								// у внутренних вызовов первым аргументом обычно идёт ключ (string)
-								if len(innerCall.Args) > 0 {
-									if bl, ok := innerCall.Args[0].(*ast.BasicLit); ok && bl.Kind == token.STRING {
-										key, err := strconv.Unquote(bl.Value)
-										if err != nil {
-											key = strings.Trim(bl.Value, "\"`")
-										}
-										// проверяем ключ на чувствительные слова
-										checkSensitiveKeyLiteral(pass, innerCall.Pos(), key, cfg)
-									}
-								}
-							}
-						}
-					}
-				}
-			}
+	if ok, sensitive := checkSensitiveKeys(key.name, targetKey, cfg); ok {
+		var fixes []analysis.SuggestedFix
+		if key.value != nil {
+			fixes = redactFix(pass, []ast.Expr{key.value}, cfg)
 		}
+		report(pass, cfg, RuleSensitiveData, analysis.Diagnostic{
+			Pos:            key.pos,
+			Message:        fmt.Sprintf("log message may contain sensitive data (found %q): %q", sensitive, key.name),
+			SuggestedFixes: fixes,
+		})
 	}
 }
 
-func checkSensitiveKeyLiteral(pass *analysis.Pass, pos token.Pos, key string, cfg Config) {
-	if ok, sensitive := checkSensitiveKeys(key, cfg); ok {
-		pass.Reportf(pos, "log message may contain sensitive data (found %q): %q", sensitive, key)
-	}
-}
-
-var Analyzer = NewAnalyzer(Config{
-	AllowedPunctuation:      ",-/:()",
-	CustomSensitivePatterns: []string{},
-	IgnoreZapFields:         false,
-})
+// Analyzer — анализатор с настройками по умолчанию, которые всегда корректны
+var Analyzer, _ = NewAnalyzer(DefaultConfig())
@@ -0,0 +1,82 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// referencedObject возвращает объект, на который ссылается идентификатор или селектор pkg.Name
+func referencedObject(pass *analysis.Pass, expr ast.Expr) types.Object {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return pass.TypesInfo.Uses[e]
+	case *ast.SelectorExpr:
+		return pass.TypesInfo.Uses[e.Sel]
+	}
+	return nil
+}
+
+// constantMessage вычисляет значение именованной строковой константы (в том числе типизированной
+// и объявленной в другом пакете). Если константа объявлена в текущем пакете строковым литералом,
+// возвращается и этот литерал — исправление предлагается в месте объявления.
+func constantMessage(pass *analysis.Pass, expr ast.Expr) (string, *ast.BasicLit, bool) {
+	c, ok := referencedObject(pass, expr).(*types.Const)
+	if !ok || c.Val().Kind() != constant.String {
+		return "", nil, false
+	}
+	return constant.StringVal(c.Val()), declarationLiteral(pass, c), true
+}
+
+// packageVarMessage возвращает значение строковой переменной уровня пакета, если она объявлена
+// в текущем пакете и инициализирована строковым литералом
+func packageVarMessage(pass *analysis.Pass, expr ast.Expr) (string, *ast.BasicLit, bool) {
+	v, ok := referencedObject(pass, expr).(*types.Var)
+	if !ok || v.Pkg() != pass.Pkg || v.Parent() != pass.Pkg.Scope() || !isStringType(v.Type()) {
+		return "", nil, false
+	}
+	bl := declarationLiteral(pass, v)
+	if bl == nil {
+		return "", nil, false
+	}
+	msg, ok := stringLiteralValue(bl)
+	return msg, bl, ok
+}
+
+// declarationLiteral находит строковый литерал, которым инициализирован объект в его объявлении
+// в текущем пакете
+func declarationLiteral(pass *analysis.Pass, obj types.Object) *ast.BasicLit {
+	if obj.Pkg() != pass.Pkg {
+		return nil
+	}
+	for _, file := range pass.Files {
+		if obj.Pos() < file.Pos() || obj.Pos() >= file.End() {
+			continue
+		}
+		var lit *ast.BasicLit
+		ast.Inspect(file, func(n ast.Node) bool {
+			if lit != nil {
+				return false
+			}
+			spec, ok := n.(*ast.ValueSpec)
+			if !ok {
+				return true
+			}
+			for i, name := range spec.Names {
+				if pass.TypesInfo.Defs[name] != obj || i >= len(spec.Values) {
+					continue
+				}
+				if bl, ok := ast.Unparen(spec.Values[i]).(*ast.BasicLit); ok {
+					if _, ok := stringLiteralValue(bl); ok {
+						lit = bl
+					}
+				}
+			}
+			return false
+		})
+		return lit
+	}
+	return nil
+}
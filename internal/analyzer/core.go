@@ -1,7 +1,6 @@
 package analyzer
 
 import (
-	"regexp"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -21,19 +20,35 @@ func checkStartWithLowercase(message string) (bool, string) {
 	return false, ""
 }
 
+// fixStartWithLowercase делает строчной первую букву сообщения, сохраняя начальные пробелы
+func fixStartWithLowercase(message string) string {
+	trimmed := strings.TrimLeftFunc(message, unicode.IsSpace)
+	r, size := utf8.DecodeRuneInString(trimmed)
+	if unicode.IsLetter(r) && unicode.IsUpper(r) {
+		return message[:len(message)-len(trimmed)] + string(unicode.ToLower(r)) + trimmed[size:]
+	}
+	return message
+}
+
 func checkEnglishOnly(message string, cfg Config) (bool, string) {
 	trimmed := strings.TrimSpace(message)
+	fixed := removeNonLatinLetters(trimmed)
+	if fixed != trimmed {
+		return true, fixed
+	}
+	return false, ""
+}
+
+// removeNonLatinLetters удаляет из сообщения буквы нелатинских алфавитов
+func removeNonLatinLetters(message string) string {
 	var b strings.Builder
-	for _, ch := range trimmed {
+	for _, ch := range message {
 		if unicode.IsLetter(ch) && !unicode.In(ch, unicode.Latin) {
 			continue
 		}
 		b.WriteRune(ch)
 	}
-	if b.String() != trimmed {
-		return true, b.String()
-	}
-	return false, ""
+	return b.String()
 }
 
 var sensitive = []string{
@@ -41,38 +56,127 @@ var sensitive = []string{
 	"token", "secret", "ssn", "credit", "card", "cardnumber", "private key", "private_key",
 }
 
-func checkSensitiveKeys(message string, cfg Config) (bool, string) {
-	low := strings.ToLower(message)
-	for _, kw := range sensitive {
-		if strings.Contains(low, kw) {
-			return true, kw
+// safeTerms — встроенные безопасные термины, содержащие чувствительные слова
+var safeTerms = []string{"token_count", "token_type", "token_limit"}
+
+var (
+	sensitiveWords = splitAll(sensitive)
+	safeTermWords  = splitAll(safeTerms)
+)
+
+// splitWords разбивает текст на слова в нижнем регистре по пробелам, знакам препинания,
+// подчёркиваниям, дефисам и смене регистра: "userAPIKey" -> [user api key]
+func splitWords(s string) []string {
+	var words []string
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words = append(words, strings.Split(snakeCase(part), "_")...)
+	}
+	return words
+}
+
+func splitAll(terms []string) [][]string {
+	res := make([][]string, 0, len(terms))
+	for _, t := range terms {
+		if words := splitWords(t); len(words) > 0 {
+			res = append(res, words)
+		}
+	}
+	return res
+}
+
+// indexWords возвращает позиции, с которых в words начинается последовательность слов seq.
+// Слово совпадает и во множественном числе: "tokens" и "api_keys" находятся по "token" и "api_key"
+func indexWords(words, seq []string) []int {
+	var res []int
+	for i := 0; i+len(seq) <= len(words); i++ {
+		match := true
+		for j, w := range seq {
+			if words[i+j] != w && words[i+j] != w+"s" {
+				match = false
+				break
+			}
+		}
+		if match {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// checkSensitiveKeys ищет в сообщении или ключе чувствительные слова. Ключевые слова сравниваются
+// целыми словами: "pass" не находится в "passenger", а "card" — в "discard".
+// Слова, входящие в безопасные термины (встроенные и Config.SafeKeys), не учитываются:
+// "token" в "token_count" не является чувствительными данными.
+// Затем проверяются пользовательские шаблоны для target; возвращается имя совпавшего шаблона.
+func checkSensitiveKeys(message string, target patternTarget, cfg Config) (bool, string) {
+	words := splitWords(message)
+	masked := make([]bool, len(words))
+	for _, seq := range cfg.safeWords {
+		if len(seq) == len(words) && len(indexWords(words, seq)) > 0 {
+			// безопасный термин целиком никогда не считается чувствительным
+			return false, ""
+		}
+		for _, i := range indexWords(words, seq) {
+			for j := range seq {
+				masked[i+j] = true
+			}
 		}
 	}
-	for _, pattern := range cfg.CustomSensitivePatterns {
-		matched, err := regexp.MatchString(pattern, low)
-		if err == nil && matched {
-			return true, pattern
+	for k, seq := range sensitiveWords {
+		for _, i := range indexWords(words, seq) {
+			if !allMasked(masked[i : i+len(seq)]) {
+				return true, sensitive[k]
+			}
 		}
 	}
+	if name, ok := matchPatterns(message, target, cfg); ok {
+		return true, name
+	}
 	return false, ""
 }
 
+func allMasked(masked []bool) bool {
+	for _, m := range masked {
+		if !m {
+			return false
+		}
+	}
+	return true
+}
+
 func checkDisallowedSymbols(message string, cfg Config) (bool, string) {
 	allowed := buildAllowedPunctuation(cfg)
 	for _, ch := range message {
-		if unicode.IsLetter(ch) || unicode.IsDigit(ch) {
-			continue
-		}
-		if allowed[ch] {
-			continue
-		}
-		if unicode.IsControl(ch) || unicode.IsSymbol(ch) || unicode.IsMark(ch) || unicode.IsPunct(ch) {
+		if isDisallowedSymbol(ch, allowed) {
 			return true, string(ch)
 		}
 	}
 	return false, ""
 }
 
+// removeDisallowedSymbols удаляет из сообщения все запрещённые символы
+func removeDisallowedSymbols(message string, cfg Config) string {
+	allowed := buildAllowedPunctuation(cfg)
+	return strings.Map(func(ch rune) rune {
+		if isDisallowedSymbol(ch, allowed) {
+			return -1
+		}
+		return ch
+	}, message)
+}
+
+func isDisallowedSymbol(ch rune, allowed map[rune]bool) bool {
+	if unicode.IsLetter(ch) || unicode.IsDigit(ch) {
+		return false
+	}
+	if allowed[ch] {
+		return false
+	}
+	return unicode.IsControl(ch) || unicode.IsSymbol(ch) || unicode.IsMark(ch) || unicode.IsPunct(ch)
+}
+
 func buildAllowedPunctuation(cfg Config) map[rune]bool {
 	allowed := make(map[rune]bool)
 	for _, r := range cfg.AllowedPunctuation {
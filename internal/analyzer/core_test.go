@@ -239,6 +239,66 @@ func Test_checkSensitiveKeys(t *testing.T) {
 			want:  false,
 			want1: "",
 		},
+		{
+			name:  "keyword inside word",
+			args:  args{message: "passenger boarded, discard cache", cfg: Config{}},
+			want:  false,
+			want1: "",
+		},
+		{
+			name:  "plural key",
+			args:  args{message: "api_keys", cfg: Config{}},
+			want:  true,
+			want1: "api_key",
+		},
+		{
+			name:  "plural word",
+			args:  args{message: "refresh tokens", cfg: Config{}},
+			want:  true,
+			want1: "token",
+		},
+		{
+			name:  "plural camel case key",
+			args:  args{message: "userPasswords", cfg: Config{}},
+			want:  true,
+			want1: "password",
+		},
+		{
+			name:  "camel case key",
+			args:  args{message: "userPassword", cfg: Config{}},
+			want:  true,
+			want1: "password",
+		},
+		{
+			name:  "acronym key",
+			args:  args{message: "APIKey", cfg: Config{}},
+			want:  true,
+			want1: "api_key",
+		},
+		{
+			name:  "builtin safe term",
+			args:  args{message: "token_count", cfg: Config{}},
+			want:  false,
+			want1: "",
+		},
+		{
+			name:  "safe term does not hide other keywords",
+			args:  args{message: "token count for secret", cfg: Config{}},
+			want:  true,
+			want1: "secret",
+		},
+		{
+			name:  "configured safe key",
+			args:  args{message: "csrf-token", cfg: Config{SafeKeys: []string{"csrf_token"}}},
+			want:  false,
+			want1: "",
+		},
+		{
+			name:  "safe key is never flagged by custom patterns",
+			args:  args{message: "session_id", cfg: Config{SafeKeys: []string{"sessionID"}, CustomSensitivePatterns: []string{"session"}}},
+			want:  false,
+			want1: "",
+		},
 		{
 			name:  "regex with groups",
 			args:  args{message: "token: abc123", cfg: Config{CustomSensitivePatterns: []string{"token:\\s*\\w+"}}},
@@ -248,7 +308,11 @@ func Test_checkSensitiveKeys(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, got1 := checkSensitiveKeys(tt.args.message, tt.args.cfg)
+			cfg := tt.args.cfg
+			if err := cfg.prepare(); err != nil {
+				t.Fatal(err)
+			}
+			got, got1 := checkSensitiveKeys(tt.args.message, targetMessage, cfg)
 			if got != tt.want {
 				t.Errorf("checkSensitiveKeys() got = %v, want %v", got, tt.want)
 			}
@@ -312,3 +376,44 @@ func Test_checkStartWithLowercase(t *testing.T) {
 		})
 	}
 }
+
+func Test_fixStartWithLowercase(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{name: "starts with uppercase", message: "Hello world", want: "hello world"},
+		{name: "keeps surrounding spaces", message: "  Hello world ", want: "  hello world "},
+		{name: "starts with lowercase", message: "hello", want: "hello"},
+		{name: "starts with number", message: "1 Hello", want: "1 Hello"},
+		{name: "empty message", message: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fixStartWithLowercase(tt.message); got != tt.want {
+				t.Errorf("fixStartWithLowercase() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_removeDisallowedSymbols(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		cfg     Config
+		want    string
+	}{
+		{name: "no disallowed symbols", message: "hello, world", cfg: Config{AllowedPunctuation: ","}, want: "hello, world"},
+		{name: "all disallowed symbols", message: "hello@world!!! 🚀", cfg: Config{AllowedPunctuation: ","}, want: "helloworld "},
+		{name: "keeps allowed punctuation", message: "hello, world!", cfg: Config{AllowedPunctuation: ",!"}, want: "hello, world!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := removeDisallowedSymbols(tt.message, tt.cfg); got != tt.want {
+				t.Errorf("removeDisallowedSymbols() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	ignoreDirective     = "//prettyloglint:ignore"
+	fileIgnoreDirective = "//prettyloglint:file-ignore"
+)
+
+// directive — комментарий подавления диагностик:
+//
+//	//prettyloglint:ignore sensitive-data -- key is a token count
+//	//prettyloglint:file-ignore english-only,disallowed-symbols -- legacy messages
+//
+// Без списка правил (или со значением all) подавляются все правила.
+// Комментарий в конце строки действует на эту строку, комментарий на отдельной строке —
+// на следующий за ним оператор или объявление целиком.
+type directive struct {
+	pos       token.Pos
+	file      string
+	startLine int
+	endLine   int
+	rules     []string // пусто — все правила
+	reason    string
+	used      bool
+}
+
+func (d *directive) matches(rule string) bool {
+	if len(d.rules) == 0 {
+		return true
+	}
+	for _, r := range d.rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDirectives находит директивы подавления во всех файлах пакета
+func parseDirectives(pass *analysis.Pass) []*directive {
+	var directives []*directive
+	for _, file := range pass.Files {
+		tokFile := pass.Fset.File(file.Pos())
+		if tokFile == nil {
+			continue
+		}
+		for _, group := range file.Comments {
+			for _, c := range group.List {
+				d, ok := parseDirective(c.Text)
+				if !ok {
+					continue
+				}
+				d.pos = c.Pos()
+				d.file = tokFile.Name()
+				if d.startLine == 0 {
+					d.startLine, d.endLine = directiveLines(pass.Fset, file, group, c)
+				}
+				directives = append(directives, d)
+			}
+		}
+	}
+	return directives
+}
+
+// parseDirective разбирает текст комментария. Для file-ignore диапазон строк — весь файл.
+func parseDirective(text string) (*directive, bool) {
+	var rest string
+	d := &directive{}
+	switch {
+	case strings.HasPrefix(text, fileIgnoreDirective):
+		rest = strings.TrimPrefix(text, fileIgnoreDirective)
+		d.startLine, d.endLine = 1, int(^uint(0)>>1)
+	case strings.HasPrefix(text, ignoreDirective):
+		rest = strings.TrimPrefix(text, ignoreDirective)
+	default:
+		return nil, false
+	}
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		// например //prettyloglint:ignored
+		return nil, false
+	}
+	// после директивы может идти обычный комментарий: //prettyloglint:ignore all -- reason // note
+	if i := strings.Index(rest, "//"); i >= 0 {
+		rest = rest[:i]
+	}
+	fields := strings.Fields(rest)
+	if len(fields) > 0 && fields[0] != "--" {
+		if fields[0] != "all" {
+			d.rules = strings.Split(fields[0], ",")
+		}
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && fields[0] == "--" {
+		fields = fields[1:]
+	}
+	d.reason = strings.Join(fields, " ")
+	return d, true
+}
+
+// directiveLines определяет строки, на которые действует директива
+func directiveLines(fset *token.FileSet, file *ast.File, group *ast.CommentGroup, c *ast.Comment) (int, int) {
+	line := fset.Position(c.Pos()).Line
+	if !commentOnOwnLine(fset, file, c) {
+		return line, line
+	}
+	// директива на отдельной строке действует на следующий за группой комментариев
+	// оператор или объявление целиком
+	start := fset.Position(group.End()).Line + 1
+	end := start
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n == nil || fset.Position(n.End()).Line < start {
+			return false
+		}
+		switch n.(type) {
+		case ast.Stmt, ast.Decl, ast.Spec:
+			if fset.Position(n.Pos()).Line == start {
+				end = max(end, fset.Position(n.End()).Line)
+				return false
+			}
+		}
+		return fset.Position(n.Pos()).Line <= start
+	})
+	return start, end
+}
+
+// commentOnOwnLine проверяет, что перед комментарием на его строке нет кода
+func commentOnOwnLine(fset *token.FileSet, file *ast.File, c *ast.Comment) bool {
+	line := fset.Position(c.Pos()).Line
+	ownLine := true
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n == nil || !ownLine || n.Pos() > c.Pos() {
+			return false
+		}
+		switch n.(type) {
+		case *ast.File:
+			return true
+		case *ast.CommentGroup, *ast.Comment:
+			return false
+		}
+		if n.End() <= c.Pos() {
+			if fset.Position(n.End()).Line == line {
+				ownLine = false
+			}
+			return false
+		}
+		return true
+	})
+	return ownLine
+}
+
+// suppress проверяет, подавлена ли диагностика директивой, и отмечает директиву как использованную
+func suppress(fset *token.FileSet, directives []*directive, d analysis.Diagnostic) bool {
+	pos := fset.Position(d.Pos)
+	endLine := pos.Line
+	if d.End.IsValid() {
+		endLine = fset.Position(d.End).Line
+	}
+	suppressed := false
+	for _, dir := range directives {
+		// диагностика многострочного вызова подавляется директивой на любой из его строк
+		if dir.file != pos.Filename || endLine < dir.startLine || pos.Line > dir.endLine {
+			continue
+		}
+		if dir.matches(d.Category) {
+			dir.used = true
+			suppressed = true
+		}
+	}
+	return suppressed
+}
+
+// reportDirectiveProblems сообщает о директивах с неизвестными правилами, а также (если включено
+// в конфигурации) о неиспользованных директивах и директивах без причины
+func reportDirectiveProblems(pass *analysis.Pass, directives []*directive, cfg Config) {
+	for _, dir := range directives {
+		for _, rule := range dir.rules {
+			if !knownRules[rule] {
+				reportf(pass, cfg, RuleDirectives, dir.pos, "unknown rule %q in prettyloglint directive", rule)
+			}
+		}
+		if cfg.RequireDirectiveReason && dir.reason == "" {
+			reportf(pass, cfg, RuleDirectives, dir.pos, "prettyloglint directive should explain the reason after \"--\"")
+		}
+		if cfg.ReportUnusedDirectives && !dir.used {
+			reportf(pass, cfg, RuleDirectives, dir.pos, "unused prettyloglint directive")
+		}
+	}
+}
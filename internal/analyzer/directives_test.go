@@ -0,0 +1,60 @@
+package analyzer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseDirective(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		wantOK     bool
+		wantRules  []string
+		wantReason string
+	}{
+		{name: "not a directive", text: "// regular comment", wantOK: false},
+		{name: "similar prefix", text: "//prettyloglint:ignored", wantOK: false},
+		{name: "all rules", text: "//prettyloglint:ignore", wantOK: true},
+		{name: "explicit all", text: "//prettyloglint:ignore all -- reason", wantOK: true, wantReason: "reason"},
+		{
+			name:       "single rule with reason",
+			text:       "//prettyloglint:ignore sensitive-data -- key is a token count",
+			wantOK:     true,
+			wantRules:  []string{"sensitive-data"},
+			wantReason: "key is a token count",
+		},
+		{
+			name:       "several rules without separator",
+			text:       "//prettyloglint:ignore english-only,lowercase-start legacy",
+			wantOK:     true,
+			wantRules:  []string{"english-only", "lowercase-start"},
+			wantReason: "legacy",
+		},
+		{name: "reason only", text: "//prettyloglint:ignore -- intentional", wantOK: true, wantReason: "intentional"},
+		{
+			name:       "trailing comment",
+			text:       "//prettyloglint:file-ignore english-only -- legacy // note",
+			wantOK:     true,
+			wantRules:  []string{"english-only"},
+			wantReason: "legacy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseDirective(tt.text)
+			if ok != tt.wantOK {
+				t.Fatalf("parseDirective() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if !reflect.DeepEqual(got.rules, tt.wantRules) {
+				t.Errorf("parseDirective() rules = %v, want %v", got.rules, tt.wantRules)
+			}
+			if got.reason != tt.wantReason {
+				t.Errorf("parseDirective() reason = %q, want %q", got.reason, tt.wantReason)
+			}
+		})
+	}
+}
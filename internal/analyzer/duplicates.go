@@ -0,0 +1,131 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// boundFields отслеживает в пределах функции поля, привязанные к логерам через With:
+//
+//	l := logger.With(zap.String("id", a))
+//	l.Info("started", zap.Int("id", b)) // ключ "id" повторяется
+type boundFields struct {
+	pass    *analysis.Pass
+	loggers loggerIndex
+	vars    map[types.Object][]fieldKey
+}
+
+func newBoundFields(pass *analysis.Pass, loggers loggerIndex) *boundFields {
+	return &boundFields{pass: pass, loggers: loggers, vars: make(map[types.Object][]fieldKey)}
+}
+
+// record запоминает поля, привязанные к переменным в присваивании или объявлении
+func (b *boundFields) record(n ast.Node) {
+	if b == nil {
+		return
+	}
+	var lhs []*ast.Ident
+	var rhs []ast.Expr
+	switch n := n.(type) {
+	case *ast.AssignStmt:
+		rhs = n.Rhs
+		for _, e := range n.Lhs {
+			ident, _ := e.(*ast.Ident)
+			lhs = append(lhs, ident)
+		}
+	case *ast.ValueSpec:
+		lhs, rhs = n.Names, n.Values
+	default:
+		return
+	}
+	if len(lhs) != len(rhs) {
+		return
+	}
+	for i, ident := range lhs {
+		if ident == nil {
+			continue
+		}
+		obj := b.pass.TypesInfo.ObjectOf(ident)
+		if obj == nil {
+			continue
+		}
+		if keys := b.keysOf(rhs[i]); len(keys) > 0 {
+			b.vars[obj] = keys
+		} else {
+			delete(b.vars, obj)
+		}
+	}
+}
+
+// keysOf возвращает поля, привязанные к логеру, который получается вычислением выражения
+func (b *boundFields) keysOf(expr ast.Expr) []fieldKey {
+	if b == nil {
+		return nil
+	}
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return b.vars[b.pass.TypesInfo.Uses[e]]
+	case *ast.CallExpr:
+		var recv []fieldKey
+		if sel, ok := ast.Unparen(e.Fun).(*ast.SelectorExpr); ok {
+			if s, ok := b.pass.TypesInfo.Selections[sel]; ok && s.Kind() == types.MethodVal {
+				recv = b.keysOf(sel.X)
+			}
+		}
+		logger, ok := b.loggers.lookup(b.pass, e)
+		if !ok || logger.MessageIndex >= 0 {
+			// остальные методы (Sugar, Named, Info() у zerolog, поля цепочки) сохраняют привязанные поля;
+			// поля цепочки учитываются в самом вызове логера
+			return recv
+		}
+		if logger.Fields == FieldsName {
+			// поля после slog WithGroup попадают в группу и с привязанными не пересекаются
+			if logger.Package == slogPackage {
+				return nil
+			}
+			return recv
+		}
+		keys := append([]fieldKey(nil), recv...)
+		return append(keys, collectFields(b.pass, e, logger)...)
+	}
+	return nil
+}
+
+// receiverKeys возвращает поля, привязанные к получателю вызова логера
+func (b *boundFields) receiverKeys(callExpr *ast.CallExpr) []fieldKey {
+	sel, ok := ast.Unparen(callExpr.Fun).(*ast.SelectorExpr)
+	if !ok || b == nil {
+		return nil
+	}
+	if s, ok := b.pass.TypesInfo.Selections[sel]; !ok || s.Kind() != types.MethodVal {
+		return nil
+	}
+	return b.keysOf(sel.X)
+}
+
+// checkDuplicateKeys сообщает о ключах, повторяющихся в полях вызова или совпадающих с полями,
+// привязанными к логеру через With в той же функции
+func checkDuplicateKeys(pass *analysis.Pass, callExpr *ast.CallExpr, keys []fieldKey, bound *boundFields, cfg Config) {
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key.name] {
+			reportf(pass, cfg, RuleDuplicateKeys, key.pos, "duplicate log field key %q", key.name)
+		}
+		seen[key.name] = true
+	}
+	for _, b := range bound.receiverKeys(callExpr) {
+		for _, key := range keys {
+			if key.name == b.name {
+				// место привязки передаётся отдельно: номер строки в тексте сломал бы сопоставление с baseline
+				report(pass, cfg, RuleDuplicateKeys, analysis.Diagnostic{
+					Pos:     key.pos,
+					Message: fmt.Sprintf("duplicate log field key %q: already bound by With", key.name),
+					Related: []analysis.RelatedInformation{{Pos: b.pos, Message: fmt.Sprintf("log field key %q bound here", b.name)}},
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// fieldKey — ключ структурированного поля, найденный в вызове логера
+type fieldKey struct {
+	name  string        // значение ключа с путём групп через точку: "auth.password"
+	lit   *ast.BasicLit // литерал ключа
+	pos   token.Pos     // позиция для отчёта
+	value ast.Expr      // выражение значения, если оно известно
+}
+
+// collectFields извлекает ключи полей вызова логера в соответствии с его описанием
+func collectFields(pass *analysis.Pass, callExpr *ast.CallExpr, l *LoggerConfig) []fieldKey {
+	var keys []fieldKey
+	var args []ast.Expr
+	if start := l.MessageIndex + 1; start < len(callExpr.Args) {
+		args = callExpr.Args[start:]
+	}
+	switch l.Fields {
+	case FieldsConstructors:
+		keys = collectConstructors(pass, keys, args, l.Package, "")
+	case FieldsKeyValue:
+		keys = collectKeyValues(pass, keys, args, l.Package, "")
+	case FieldsMap:
+		for _, arg := range args {
+			keys = appendMapKeys(keys, arg)
+		}
+	case FieldsChain:
+		if sel, ok := ast.Unparen(callExpr.Fun).(*ast.SelectorExpr); ok {
+			keys = collectChain(pass, keys, sel.X, "")
+		}
+	case FieldsName:
+		for _, arg := range args {
+			if lit, ok := ast.Unparen(arg).(*ast.BasicLit); ok {
+				if name, ok := stringLiteralValue(lit); ok {
+					keys = append(keys, fieldKey{name: name, lit: lit, pos: lit.Pos()})
+				}
+			}
+		}
+	}
+	return keys
+}
+
+// fieldConstructor возвращает вызов функции-помощника пакета логера (zap.String/Any/Int64 и т.п.),
+// если выражение им является
+func fieldConstructor(pass *analysis.Pass, expr ast.Expr, pkg string) (*ast.CallExpr, *types.Signature, bool) {
+	innerCall, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return nil, nil, false
+	}
+	fn, ok := calledFunc(pass, innerCall)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != pkg {
+		return nil, nil, false
+	}
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() != nil {
+		return nil, nil, false
+	}
+	return innerCall, sig, true
+}
+
+// isFieldValue проверяет, что выражение — готовое поле пакета логера (zap.Field, slog.Attr и т.п.)
+func isFieldValue(pass *analysis.Pass, expr ast.Expr, pkg string) bool {
+	named, ok := namedType(pass.TypesInfo.TypeOf(expr))
+	if !ok || named.Obj().Pkg().Path() != pkg {
+		return false
+	}
+	_, isBasic := named.Underlying().(*types.Basic)
+	return !isBasic
+}
+
+// collectConstructors просматривает поля, созданные функциями-помощниками пакета логера
+func collectConstructors(pass *analysis.Pass, keys []fieldKey, args []ast.Expr, pkg, prefix string) []fieldKey {
+	for _, arg := range args {
+		keys = appendConstructorKey(pass, keys, arg, pkg, prefix)
+	}
+	return keys
+}
+
+// appendConstructorKey добавляет ключ поля, созданного функцией-помощником: zap.String("key", v).
+// Для групп (slog.Group("auth", ...), zap.Dict("auth", ...)) ключи вложенных полей
+// добавляются с путём группы через точку: "auth.password".
+func appendConstructorKey(pass *analysis.Pass, keys []fieldKey, arg ast.Expr, pkg, prefix string) []fieldKey {
+	innerCall, sig, ok := fieldConstructor(pass, arg, pkg)
+	if !ok || len(innerCall.Args) == 0 {
+		return keys
+	}
+	// опция с набором полей: logger.WithOptions(zap.Fields(zap.String("key", v)))
+	if isFieldsOption(sig, pkg) {
+		if innerCall.Ellipsis.IsValid() {
+			return keys
+		}
+		return collectConstructors(pass, keys, innerCall.Args, pkg, prefix)
+	}
+	// у внутренних вызовов первым аргументом обычно идёт ключ (string)
+	lit, ok := ast.Unparen(innerCall.Args[0]).(*ast.BasicLit)
+	if !ok {
+		return keys
+	}
+	key, ok := stringLiteralValue(lit)
+	if !ok {
+		return keys
+	}
+	if nested, ok := groupElem(sig, pkg); ok {
+		keys = append(keys, fieldKey{name: prefix + key, lit: lit, pos: innerCall.Pos()})
+		if innerCall.Ellipsis.IsValid() {
+			return keys
+		}
+		if nested == FieldsKeyValue {
+			return collectKeyValues(pass, keys, innerCall.Args[1:], pkg, prefix+key+".")
+		}
+		return collectConstructors(pass, keys, innerCall.Args[1:], pkg, prefix+key+".")
+	}
+	var value ast.Expr
+	if len(innerCall.Args) > 1 {
+		value = innerCall.Args[1]
+	}
+	return append(keys, fieldKey{name: prefix + key, lit: lit, pos: innerCall.Pos(), value: value})
+}
+
+// groupElem проверяет, что функция-помощник создаёт группу полей: после ключа-строки
+// идёт variadic-параметр с парами ключ/значение (slog.Group) или с полями пакета логера (zap.Dict)
+func groupElem(sig *types.Signature, pkg string) (FieldStyle, bool) {
+	params := sig.Params()
+	if !sig.Variadic() || params.Len() != 2 || !isStringType(params.At(0).Type()) {
+		return FieldsNone, false
+	}
+	elem := params.At(1).Type().(*types.Slice).Elem()
+	if iface, ok := elem.Underlying().(*types.Interface); ok && iface.Empty() {
+		return FieldsKeyValue, true
+	}
+	if named, ok := namedType(elem); ok && named.Obj().Pkg().Path() == pkg {
+		return FieldsConstructors, true
+	}
+	return FieldsNone, false
+}
+
+// isFieldsOption проверяет, что функция-помощник принимает только поля пакета логера: zap.Fields(...)
+func isFieldsOption(sig *types.Signature, pkg string) bool {
+	if !sig.Variadic() || sig.Params().Len() != 1 {
+		return false
+	}
+	elem := sig.Params().At(0).Type().(*types.Slice).Elem()
+	named, ok := namedType(elem)
+	return ok && named.Obj().Pkg().Path() == pkg
+}
+
+// collectKeyValues просматривает чередующиеся пары ключ/значение.
+// Среди пар могут встречаться и готовые поля пакета логера — они занимают один аргумент.
+func collectKeyValues(pass *analysis.Pass, keys []fieldKey, args []ast.Expr, pkg, prefix string) []fieldKey {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if isFieldValue(pass, arg, pkg) {
+			keys = appendConstructorKey(pass, keys, arg, pkg, prefix)
+			continue
+		}
+		if lit, ok := ast.Unparen(arg).(*ast.BasicLit); ok {
+			if key, ok := stringLiteralValue(lit); ok {
+				var value ast.Expr
+				if i+1 < len(args) {
+					value = args[i+1]
+				}
+				keys = append(keys, fieldKey{name: prefix + key, lit: lit, pos: lit.Pos(), value: value})
+			}
+		}
+		// следующий аргумент — значение
+		i++
+	}
+	return keys
+}
+
+// appendMapKeys добавляет ключи литерала map: logrus.Fields{"key": v}
+func appendMapKeys(keys []fieldKey, arg ast.Expr) []fieldKey {
+	lit, ok := ast.Unparen(arg).(*ast.CompositeLit)
+	if !ok {
+		return keys
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		keyLit, ok := ast.Unparen(kv.Key).(*ast.BasicLit)
+		if !ok {
+			continue
+		}
+		if key, ok := stringLiteralValue(keyLit); ok {
+			keys = append(keys, fieldKey{name: key, lit: keyLit, pos: keyLit.Pos(), value: kv.Value})
+		}
+	}
+	return keys
+}
+
+// collectChain спускается по цепочке вызовов методов получателя и собирает ключи полей
+// (.Str("key", ...), .Int(...), .Dict(...) и т.п.). Полем считается метод, первым параметром
+// которого идёт ключ-строка, за которым следует значение. Ключи вложенного словаря получают путь
+// через точку, как в группах slog: .Dict("auth", zerolog.Dict().Str("id", v)) -> "auth.id".
+func collectChain(pass *analysis.Pass, keys []fieldKey, expr ast.Expr, prefix string) []fieldKey {
+	recvType, ok := namedType(pass.TypesInfo.TypeOf(expr))
+	if !ok {
+		return keys
+	}
+	for {
+		call, ok := ast.Unparen(expr).(*ast.CallExpr)
+		if !ok {
+			return keys
+		}
+		sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return keys
+		}
+		if named, ok := namedType(pass.TypesInfo.TypeOf(sel.X)); !ok || named.Obj() != recvType.Obj() {
+			// дошли до начала цепочки: log.Info(), logger.Error(), zerolog.Dict()
+			return keys
+		}
+		// вызов с одним аргументом или с распаковкой среза (KV("a"), KV("a", vals...)) значения поля не содержит
+		if isChainFieldMethod(pass, sel) && len(call.Args) >= 2 && !call.Ellipsis.IsValid() {
+			key, ok := stringLiteralValue(ast.Unparen(call.Args[0]))
+			if !ok {
+				// без известного ключа путь полей вложенного словаря не построить
+				expr = sel.X
+				continue
+			}
+			lit := ast.Unparen(call.Args[0]).(*ast.BasicLit)
+			keys = append(keys, fieldKey{name: prefix + key, lit: lit, pos: lit.Pos(), value: call.Args[1]})
+			// вложенный словарь: .Dict("auth", zerolog.Dict().Str("password", p))
+			if named, ok := namedType(pass.TypesInfo.TypeOf(call.Args[1])); ok && named.Obj() == recvType.Obj() {
+				keys = collectChain(pass, keys, call.Args[1], prefix+key+".")
+			}
+		}
+		expr = sel.X
+	}
+}
+
+// isChainFieldMethod проверяет, что метод цепочки добавляет поле: первым параметром идёт ключ-строка,
+// за которым следует значение
+func isChainFieldMethod(pass *analysis.Pass, sel *ast.SelectorExpr) bool {
+	sig, ok := pass.TypesInfo.TypeOf(sel).(*types.Signature)
+	if !ok || sig.Params().Len() < 2 {
+		return false
+	}
+	basic, ok := sig.Params().At(0).Type().(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
@@ -0,0 +1,80 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Стили ключей полей. Вместо имени стиля можно указать регулярное выражение.
+const (
+	KeyStyleSnakeCase = "snake_case"
+	KeyStyleCamelCase = "camelCase"
+	KeyStyleKebabCase = "kebab-case"
+)
+
+var keyStylePatterns = map[string]string{
+	KeyStyleSnakeCase: `^[a-z][a-z0-9]*(_[a-z0-9]+)*$`,
+	KeyStyleCamelCase: `^[a-z][a-zA-Z0-9]*$`,
+	KeyStyleKebabCase: `^[a-z][a-z0-9]*(-[a-z0-9]+)*$`,
+}
+
+// compileKeyStyle возвращает регулярное выражение для стиля ключей; nil, если стиль не задан
+func compileKeyStyle(style string) (*regexp.Regexp, error) {
+	if style == "" {
+		return nil, nil
+	}
+	if pattern, ok := keyStylePatterns[style]; ok {
+		return regexp.MustCompile(pattern), nil
+	}
+	re, err := regexp.Compile(style)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key-style %q: %w", style, err)
+	}
+	return re, nil
+}
+
+// checkKeyStyle проверяет, что ключ поля соответствует настроенному стилю.
+// Для именованных стилей предлагается переименовать ключ.
+func checkKeyStyle(pass *analysis.Pass, key fieldKey, cfg Config) {
+	if cfg.keyStyle == nil || key.lit == nil {
+		return
+	}
+	name, ok := stringLiteralValue(key.lit)
+	if !ok || cfg.keyStyle.MatchString(name) {
+		return
+	}
+	d := analysis.Diagnostic{Pos: key.lit.Pos(), End: key.lit.End()}
+	if _, named := keyStylePatterns[cfg.KeyStyle]; !named {
+		d.Message = fmt.Sprintf("log field key %q does not match pattern %q", name, cfg.KeyStyle)
+		report(pass, cfg, RuleKeyStyle, d)
+		return
+	}
+	d.Message = fmt.Sprintf("log field key %q should be %s", name, cfg.KeyStyle)
+	if fixed := convertKey(name, cfg.KeyStyle); fixed != "" && fixed != name && cfg.keyStyle.MatchString(fixed) {
+		d.SuggestedFixes = []analysis.SuggestedFix{createReplaceLiteralFix(key.lit, fixed, "rename log field key")}
+	}
+	report(pass, cfg, RuleKeyStyle, d)
+}
+
+// convertKey переводит ключ в именованный стиль: "userId", "user-id", "UserID" -> "user_id" для snake_case
+func convertKey(key, style string) string {
+	words := splitWords(key)
+	switch style {
+	case KeyStyleSnakeCase:
+		return strings.Join(words, "_")
+	case KeyStyleKebabCase:
+		return strings.Join(words, "-")
+	case KeyStyleCamelCase:
+		for i := 1; i < len(words); i++ {
+			r := []rune(words[i])
+			r[0] = unicode.ToUpper(r[0])
+			words[i] = string(r)
+		}
+		return strings.Join(words, "")
+	}
+	return ""
+}
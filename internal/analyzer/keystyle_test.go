@@ -0,0 +1,55 @@
+package analyzer
+
+import "testing"
+
+func Test_convertKey(t *testing.T) {
+	tests := []struct {
+		key   string
+		style string
+		want  string
+	}{
+		{key: "userId", style: KeyStyleSnakeCase, want: "user_id"},
+		{key: "HTTPRequest", style: KeyStyleSnakeCase, want: "http_request"},
+		{key: "request-id", style: KeyStyleSnakeCase, want: "request_id"},
+		{key: "user id", style: KeyStyleSnakeCase, want: "user_id"},
+		{key: "user_id", style: KeyStyleCamelCase, want: "userId"},
+		{key: "UserID", style: KeyStyleCamelCase, want: "userId"},
+		{key: "session_id", style: KeyStyleKebabCase, want: "session-id"},
+		{key: "userName", style: "^[a-z]+$", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key+" "+tt.style, func(t *testing.T) {
+			if got := convertKey(tt.key, tt.style); got != tt.want {
+				t.Errorf("convertKey(%q, %q) = %q, want %q", tt.key, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_compileKeyStyle(t *testing.T) {
+	tests := []struct {
+		style     string
+		key       string
+		wantMatch bool
+		wantErr   bool
+	}{
+		{style: KeyStyleSnakeCase, key: "user_id", wantMatch: true},
+		{style: KeyStyleSnakeCase, key: "userId", wantMatch: false},
+		{style: KeyStyleCamelCase, key: "userId", wantMatch: true},
+		{style: KeyStyleCamelCase, key: "user_id", wantMatch: false},
+		{style: KeyStyleKebabCase, key: "user-id", wantMatch: true},
+		{style: `^[a-z.]+$`, key: "http.method", wantMatch: true},
+		{style: `^[a-z`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.style+" "+tt.key, func(t *testing.T) {
+			re, err := compileKeyStyle(tt.style)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("compileKeyStyle(%q) error = %v, wantErr %v", tt.style, err, tt.wantErr)
+			}
+			if err == nil && re.MatchString(tt.key) != tt.wantMatch {
+				t.Errorf("key %q match = %v, want %v", tt.key, !tt.wantMatch, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkKeyValuePairs проверяет чередующиеся пары ключ/значение: у каждого ключа должно быть значение,
+// а ключ должен иметь тип string. Иначе slog и SugaredLogger выводят поле !BADKEY.
+// Готовые поля пакета логера (slog.Attr, zap.Field) занимают один аргумент.
+func checkKeyValuePairs(pass *analysis.Pass, callExpr *ast.CallExpr, l *LoggerConfig, cfg Config) {
+	if l.Fields != FieldsKeyValue || callExpr.Ellipsis.IsValid() {
+		return
+	}
+	var args []ast.Expr
+	if start := l.MessageIndex + 1; start < len(callExpr.Args) {
+		args = callExpr.Args[start:]
+	}
+	checkPairs(pass, args, l.Package, cfg)
+}
+
+func checkPairs(pass *analysis.Pass, args []ast.Expr, pkg string, cfg Config) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if isFieldValue(pass, arg, pkg) {
+			// группа с парами ключ/значение: slog.Group("auth", "user", u)
+			if innerCall, sig, ok := fieldConstructor(pass, arg, pkg); ok && !innerCall.Ellipsis.IsValid() {
+				if nested, ok := groupElem(sig, pkg); ok && nested == FieldsKeyValue && len(innerCall.Args) > 0 {
+					checkPairs(pass, innerCall.Args[1:], pkg, cfg)
+				}
+			}
+			continue
+		}
+		typ := pass.TypesInfo.TypeOf(arg)
+		if typ != nil && !isKeyType(typ) {
+			report(pass, cfg, RuleKVPairs, analysis.Diagnostic{
+				Pos:     arg.Pos(),
+				End:     arg.End(),
+				Message: fmt.Sprintf("log field key %s should be a string, got %s", types.ExprString(arg), typ),
+			})
+		} else if i+1 == len(args) {
+			report(pass, cfg, RuleKVPairs, analysis.Diagnostic{
+				Pos:     arg.Pos(),
+				End:     arg.End(),
+				Message: fmt.Sprintf("log field key %s has no value", types.ExprString(arg)),
+			})
+		}
+		// следующий аргумент — значение
+		i++
+	}
+}
+
+// isKeyType проверяет, что значение с таким типом может быть ключом пары: string или интерфейс,
+// динамический тип которого неизвестен. Именованные строковые типы логеры ключами не считают.
+func isKeyType(typ types.Type) bool {
+	if _, ok := typ.Underlying().(*types.Interface); ok {
+		return true
+	}
+	basic, ok := types.Unalias(typ).(*types.Basic)
+	return ok && (basic.Kind() == types.String || basic.Kind() == types.UntypedString)
+}
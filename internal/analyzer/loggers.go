@@ -0,0 +1,216 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// FieldStyle описывает, как логер принимает структурированные поля
+type FieldStyle string
+
+const (
+	// FieldsNone — поля не передаются
+	FieldsNone FieldStyle = "none"
+	// FieldsConstructors — поля создаются функциями пакета логера: zap.String("key", v)
+	FieldsConstructors FieldStyle = "fields"
+	// FieldsKeyValue — чередующиеся пары ключ/значение: "key", v, ...
+	// Среди пар допускаются и готовые поля пакета логера (zap.Field, slog.Attr)
+	FieldsKeyValue FieldStyle = "kv"
+	// FieldsMap — литерал map с ключами-строками: logrus.Fields{"key": v}
+	FieldsMap FieldStyle = "map"
+	// FieldsChain — поля добавляются цепочкой методов получателя: log.Info().Str("key", v).Msg("...")
+	FieldsChain FieldStyle = "chain"
+	// FieldsName — аргументы-строки являются именем логера или группы: logger.Named("auth"), logger.WithGroup("auth")
+	FieldsName FieldStyle = "name"
+)
+
+// LoggerConfig описывает методы логера, которые нужно проверять.
+// Package — путь пакета; Type — имя типа получателя (пусто для функций пакета).
+// MessageIndex — индекс аргумента-сообщения (-1, если сообщения нет, например у logger.With).
+// Поля (если есть) начинаются сразу после сообщения.
+type LoggerConfig struct {
+	Package      string     `yaml:"package"`
+	Type         string     `yaml:"type"`
+	Methods      []string   `yaml:"methods"`
+	MessageIndex int        `yaml:"message-index"`
+	Printf       bool       `yaml:"printf"`
+	Fields       FieldStyle `yaml:"fields"`
+}
+
+// validate проверяет описание логера из настроек; пустой Fields означает FieldsNone
+func (l LoggerConfig) validate() error {
+	if l.Package == "" {
+		return errors.New("package is required")
+	}
+	if len(l.Methods) == 0 {
+		return errors.New("methods are required")
+	}
+	if l.MessageIndex < -1 {
+		return fmt.Errorf("message-index should be -1 or greater, got %d", l.MessageIndex)
+	}
+	switch l.Fields {
+	case "", FieldsNone, FieldsConstructors, FieldsKeyValue, FieldsMap, FieldsChain, FieldsName:
+	default:
+		return fmt.Errorf("unknown fields %q: want %s, %s, %s, %s, %s or %s",
+			l.Fields, FieldsNone, FieldsConstructors, FieldsKeyValue, FieldsMap, FieldsChain, FieldsName)
+	}
+	return nil
+}
+
+const (
+	slogPackage    = "log/slog"
+	zapPackage     = "go.uber.org/zap"
+	zerologPackage = "github.com/rs/zerolog"
+	logrusPackage  = "github.com/sirupsen/logrus"
+)
+
+var (
+	zapLevels    = []string{"Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal"}
+	logrusLevels = []string{"Trace", "Debug", "Info", "Print", "Warn", "Warning", "Error", "Fatal", "Panic"}
+)
+
+// builtinLoggers — встроенные пресеты для поддерживаемых логеров
+var builtinLoggers = buildBuiltinLoggers()
+
+func buildBuiltinLoggers() []LoggerConfig {
+	slogMethods := []string{"Debug", "Info", "Warn", "Error"}
+	loggers := []LoggerConfig{
+		{Package: slogPackage, Methods: slogMethods, Fields: FieldsKeyValue},
+		{Package: slogPackage, Type: "Logger", Methods: slogMethods, Fields: FieldsKeyValue},
+		// slog.InfoContext(ctx, msg, args...)
+		{Package: slogPackage, Methods: withSuffix(slogMethods, "Context"), MessageIndex: 1, Fields: FieldsKeyValue},
+		{Package: slogPackage, Type: "Logger", Methods: withSuffix(slogMethods, "Context"), MessageIndex: 1, Fields: FieldsKeyValue},
+		// slog.Log(ctx, level, msg, args...)
+		{Package: slogPackage, Methods: []string{"Log"}, MessageIndex: 2, Fields: FieldsKeyValue},
+		{Package: slogPackage, Type: "Logger", Methods: []string{"Log"}, MessageIndex: 2, Fields: FieldsKeyValue},
+		{Package: slogPackage, Methods: []string{"LogAttrs"}, MessageIndex: 2, Fields: FieldsConstructors},
+		{Package: slogPackage, Type: "Logger", Methods: []string{"LogAttrs"}, MessageIndex: 2, Fields: FieldsConstructors},
+		{Package: slogPackage, Methods: []string{"With"}, MessageIndex: -1, Fields: FieldsKeyValue},
+		{Package: slogPackage, Type: "Logger", Methods: []string{"With"}, MessageIndex: -1, Fields: FieldsKeyValue},
+		{Package: slogPackage, Type: "Logger", Methods: []string{"WithGroup"}, MessageIndex: -1, Fields: FieldsName},
+
+		{Package: zapPackage, Type: "Logger", Methods: zapLevels, Fields: FieldsConstructors},
+		{Package: zapPackage, Type: "SugaredLogger", Methods: zapLevels, Fields: FieldsNone},
+		{Package: zapPackage, Type: "SugaredLogger", Methods: withSuffix(zapLevels, "f"), Printf: true, Fields: FieldsNone},
+		{Package: zapPackage, Type: "SugaredLogger", Methods: withSuffix(zapLevels, "w"), Fields: FieldsKeyValue},
+		{Package: zapPackage, Type: "SugaredLogger", Methods: withSuffix(zapLevels, "ln"), Fields: FieldsNone},
+		{Package: zapPackage, Type: "Logger", Methods: []string{"With", "WithOptions"}, MessageIndex: -1, Fields: FieldsConstructors},
+		{Package: zapPackage, Type: "SugaredLogger", Methods: []string{"With"}, MessageIndex: -1, Fields: FieldsKeyValue},
+		{Package: zapPackage, Type: "Logger", Methods: []string{"Named"}, MessageIndex: -1, Fields: FieldsName},
+		{Package: zapPackage, Type: "SugaredLogger", Methods: []string{"Named"}, MessageIndex: -1, Fields: FieldsName},
+
+		{Package: zerologPackage, Type: "Event", Methods: []string{"Msg"}, Fields: FieldsChain},
+		{Package: zerologPackage, Type: "Event", Methods: []string{"Msgf"}, Printf: true, Fields: FieldsChain},
+		{Package: zerologPackage, Type: "Event", Methods: []string{"Send"}, MessageIndex: -1, Fields: FieldsChain},
+		// контекст дочернего логера: log.With().Str("key", v).Logger()
+		{Package: zerologPackage, Type: "Context", Methods: []string{"Logger"}, MessageIndex: -1, Fields: FieldsChain},
+	}
+	for _, typ := range []string{"", "Logger", "Entry"} {
+		loggers = append(loggers,
+			LoggerConfig{Package: logrusPackage, Type: typ, Methods: logrusLevels, Fields: FieldsNone},
+			LoggerConfig{Package: logrusPackage, Type: typ, Methods: withSuffix(logrusLevels, "f"), Printf: true, Fields: FieldsNone},
+			LoggerConfig{Package: logrusPackage, Type: typ, Methods: withSuffix(logrusLevels, "ln"), Fields: FieldsNone},
+			LoggerConfig{Package: logrusPackage, Type: typ, Methods: []string{"WithField"}, MessageIndex: -1, Fields: FieldsKeyValue},
+			LoggerConfig{Package: logrusPackage, Type: typ, Methods: []string{"WithFields"}, MessageIndex: -1, Fields: FieldsMap},
+		)
+	}
+	return loggers
+}
+
+func withSuffix(methods []string, suffix string) []string {
+	res := make([]string, 0, len(methods))
+	for _, m := range methods {
+		res = append(res, m+suffix)
+	}
+	return res
+}
+
+// loggerKey — ключ поиска описания логера: пакет, тип получателя и метод
+type loggerKey struct {
+	pkg, typ, method string
+}
+
+// loggerIndex сопоставляет вызываемую функцию с описанием логера
+type loggerIndex map[loggerKey]*LoggerConfig
+
+// buildLoggerIndex объединяет встроенные пресеты с логерами из конфигурации.
+// Описания из конфигурации имеют приоритет над встроенными.
+func buildLoggerIndex(cfg Config) loggerIndex {
+	idx := make(loggerIndex)
+	add := func(loggers []LoggerConfig) {
+		for i := range loggers {
+			l := &loggers[i]
+			typ := strings.TrimPrefix(l.Type, "*")
+			for _, m := range l.Methods {
+				idx[loggerKey{pkg: l.Package, typ: typ, method: m}] = l
+			}
+		}
+	}
+	add(builtinLoggers)
+	add(cfg.Loggers)
+	return idx
+}
+
+// lookup возвращает описание логера для вызова, если вызываемая функция есть в таблице
+// или помечена как обёртка над логером. Вызовы через выражение метода ((*slog.Logger).Info(l, "msg"))
+// не проверяются: получатель в них идёт первым аргументом и индексы описания к ним не подходят.
+func (idx loggerIndex) lookup(pass *analysis.Pass, callExpr *ast.CallExpr) (*LoggerConfig, bool) {
+	if sel, ok := ast.Unparen(callExpr.Fun).(*ast.SelectorExpr); ok {
+		if s, ok := pass.TypesInfo.Selections[sel]; ok && s.Kind() == types.MethodExpr {
+			return nil, false
+		}
+	}
+	fn, ok := calledFunc(pass, callExpr)
+	if !ok || fn.Pkg() == nil {
+		return nil, false
+	}
+	fn = fn.Origin()
+	if fact := new(wrapperFact); pass.ImportObjectFact(fn, fact) {
+		return (*LoggerConfig)(fact), true
+	}
+	key := loggerKey{pkg: fn.Pkg().Path(), method: fn.Name()}
+	if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+		named, ok := namedType(recv.Type())
+		if !ok {
+			return nil, false
+		}
+		key.typ = named.Obj().Name()
+	}
+	l, ok := idx[key]
+	return l, ok
+}
+
+// calledFunc возвращает функцию или метод, вызываемый в callExpr
+func calledFunc(pass *analysis.Pass, callExpr *ast.CallExpr) (*types.Func, bool) {
+	var ident *ast.Ident
+	switch fun := ast.Unparen(callExpr.Fun).(type) {
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.Ident:
+		ident = fun
+	default:
+		return nil, false
+	}
+	fn, ok := pass.TypesInfo.Uses[ident].(*types.Func)
+	return fn, ok
+}
+
+// namedType снимает указатель и возвращает именованный тип
+func namedType(typ types.Type) (*types.Named, bool) {
+	if typ == nil {
+		return nil, false
+	}
+	if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj() == nil || named.Obj().Pkg() == nil {
+		return nil, false
+	}
+	return named, true
+}
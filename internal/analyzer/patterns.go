@@ -0,0 +1,113 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"regexp"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Где применяется пользовательский шаблон чувствительных данных
+const (
+	PatternTargetMessage = "message" // текст сообщения
+	PatternTargetKey     = "key"     // ключи полей и имена значений
+	PatternTargetValue   = "value"   // строковые значения полей, известные на этапе компиляции
+)
+
+// SensitivePattern — пользовательский шаблон чувствительных данных с настройками
+type SensitivePattern struct {
+	Name          string   `yaml:"name"`           // имя в диагностике; по умолчанию сам шаблон
+	Pattern       string   `yaml:"pattern"`        // регулярное выражение
+	CaseSensitive bool     `yaml:"case-sensitive"` // по умолчанию регистр не учитывается
+	Targets       []string `yaml:"targets"`        // message, key, value; по умолчанию message и key
+}
+
+type patternTarget uint8
+
+const (
+	targetMessage patternTarget = 1 << iota
+	targetKey
+	targetValue
+)
+
+var patternTargets = map[string]patternTarget{
+	PatternTargetMessage: targetMessage,
+	PatternTargetKey:     targetKey,
+	PatternTargetValue:   targetValue,
+}
+
+// sensitivePattern — скомпилированный шаблон
+type sensitivePattern struct {
+	name    string
+	re      *regexp.Regexp
+	targets patternTarget
+}
+
+// compilePatterns компилирует CustomSensitivePatterns и SensitivePatterns.
+// Шаблоны из CustomSensitivePatterns не учитывают регистр и применяются к сообщениям и ключам.
+func compilePatterns(cfg Config) ([]sensitivePattern, error) {
+	var patterns []sensitivePattern
+	for _, p := range cfg.CustomSensitivePatterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom-sensitive-patterns entry %q: %w", p, err)
+		}
+		patterns = append(patterns, sensitivePattern{name: p, re: re, targets: targetMessage | targetKey})
+	}
+	for _, p := range cfg.SensitivePatterns {
+		compiled, err := compilePattern(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sensitive-patterns entry %q: %w", p.Pattern, err)
+		}
+		patterns = append(patterns, compiled)
+	}
+	return patterns, nil
+}
+
+func compilePattern(p SensitivePattern) (sensitivePattern, error) {
+	if p.Pattern == "" {
+		return sensitivePattern{}, errors.New("pattern is required")
+	}
+	expr := p.Pattern
+	if !p.CaseSensitive {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return sensitivePattern{}, err
+	}
+	compiled := sensitivePattern{name: p.Name, re: re}
+	if compiled.name == "" {
+		compiled.name = p.Pattern
+	}
+	for _, t := range p.Targets {
+		target, ok := patternTargets[t]
+		if !ok {
+			return sensitivePattern{}, fmt.Errorf("unknown target %q: want %s, %s or %s", t, PatternTargetMessage, PatternTargetKey, PatternTargetValue)
+		}
+		compiled.targets |= target
+	}
+	if compiled.targets == 0 {
+		compiled.targets = targetMessage | targetKey
+	}
+	return compiled, nil
+}
+
+// matchPatterns возвращает имя первого шаблона для target, совпавшего с текстом
+func matchPatterns(text string, target patternTarget, cfg Config) (string, bool) {
+	for _, p := range cfg.patterns {
+		if p.targets&target != 0 && p.re.MatchString(text) {
+			return p.name, true
+		}
+	}
+	return "", false
+}
+
+// checkPatternValue сообщает о строковом значении поля, совпавшем с шаблоном для значений
+func checkPatternValue(pass *analysis.Pass, key fieldKey, value string, cfg Config) {
+	if name, ok := matchPatterns(value, targetValue, cfg); ok {
+		reportf(pass, cfg, RuleSensitiveData, ast.Unparen(key.value).Pos(), "log field %q value may contain sensitive data (found %q)", key.name, name)
+	}
+}
@@ -0,0 +1,44 @@
+package analyzer
+
+import "testing"
+
+func Test_matchPatterns(t *testing.T) {
+	cfg := Config{
+		CustomSensitivePatterns: []string{`internal_id`},
+		SensitivePatterns: []SensitivePattern{
+			{Name: "employee-id", Pattern: `EMP-\d{6}`, CaseSensitive: true, Targets: []string{PatternTargetValue}},
+			{Pattern: `^tenant`, Targets: []string{PatternTargetKey}},
+			{Name: "ssn", Pattern: `\b\d{3}-\d{2}-\d{4}\b`, Targets: []string{PatternTargetMessage, PatternTargetValue}},
+		},
+	}
+	if err := cfg.prepare(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		text     string
+		target   patternTarget
+		wantName string
+		wantOk   bool
+	}{
+		{name: "no match", text: "user logged in", target: targetMessage},
+		{name: "legacy pattern in message", text: "lookup by INTERNAL_ID", target: targetMessage, wantName: "internal_id", wantOk: true},
+		{name: "legacy pattern in key", text: "internal_id", target: targetKey, wantName: "internal_id", wantOk: true},
+		{name: "legacy pattern skips values", text: "internal_id", target: targetValue},
+		{name: "case sensitive match", text: "EMP-123456", target: targetValue, wantName: "employee-id", wantOk: true},
+		{name: "case sensitive mismatch", text: "emp-123456", target: targetValue},
+		{name: "value only pattern skips messages", text: "employee EMP-123456", target: targetMessage},
+		{name: "name defaults to pattern", text: "Tenant_Name", target: targetKey, wantName: "^tenant", wantOk: true},
+		{name: "key only pattern skips messages", text: "tenant created", target: targetMessage},
+		{name: "several targets", text: "applicant 078-05-1120", target: targetMessage, wantName: "ssn", wantOk: true},
+		{name: "several targets value", text: "078-05-1120", target: targetValue, wantName: "ssn", wantOk: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := matchPatterns(tt.text, tt.target, cfg)
+			if ok != tt.wantOk || name != tt.wantName {
+				t.Errorf("matchPatterns() = (%q, %v), want (%q, %v)", name, ok, tt.wantName, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package analyzer
+
+import (
+	"go/ast"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// PIIConfig включает детекторы персональных данных в тексте сообщения и строковых значениях полей
+type PIIConfig struct {
+	Email bool `yaml:"email"`
+	IP    bool `yaml:"ip"`
+	Phone bool `yaml:"phone"`
+	Card  bool `yaml:"card"`
+}
+
+// piiDetector находит персональные данные; validate отсеивает кандидатов, похожих на них только по виду
+type piiDetector struct {
+	name     string
+	enabled  func(PIIConfig) bool
+	re       *regexp.Regexp
+	validate func(string) bool
+}
+
+var piiDetectors = []piiDetector{
+	{
+		name:    "email",
+		enabled: func(c PIIConfig) bool { return c.Email },
+		re:      regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}\b`),
+	},
+	{
+		name:     "card-number",
+		enabled:  func(c PIIConfig) bool { return c.Card },
+		re:       regexp.MustCompile(`\b(\d[ -]?){12,18}\d\b`),
+		validate: luhnValid,
+	},
+	{
+		name:     "ip-address",
+		enabled:  func(c PIIConfig) bool { return c.IP },
+		re:       regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}\b|[0-9A-Fa-f]{0,4}(:[0-9A-Fa-f]{0,4}){2,7}`),
+		validate: isPersonalIP,
+	},
+	{
+		name:    "phone-number",
+		enabled: func(c PIIConfig) bool { return c.Phone },
+		// E.164: +14155552671; (415) 555-2671, 415-555-2671; +7 999 123-45-67, 8 (999) 123-45-67
+		re: regexp.MustCompile(`(^|[^\w+])\+[1-9]\d{7,14}\b` +
+			`|(\(\b|\b)\d{3}\)?[ .-]\d{3}[ .-]\d{4}\b` +
+			`|(\+\d{1,3}|\b8)[ -]?\(?\d{3}\)?[ -]?\d{3}-\d{2}-\d{2}\b`),
+	},
+}
+
+// detectPII возвращает имя включённого детектора, нашедшего персональные данные в тексте
+func detectPII(text string, cfg Config) (string, bool) {
+	for _, d := range piiDetectors {
+		if !d.enabled(cfg.PII) {
+			continue
+		}
+		for _, match := range d.re.FindAllString(text, -1) {
+			if d.validate == nil || d.validate(match) {
+				return d.name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// luhnValid проверяет номер карты по алгоритму Луна; пробелы и дефисы между цифрами игнорируются
+func luhnValid(s string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(s)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// isPersonalIP проверяет, что кандидат является IP-адресом конкретного узла:
+// loopback и неуказанный адрес (127.0.0.1, ::1, 0.0.0.0) персональными данными не считаются
+func isPersonalIP(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return false
+	}
+	return !addr.IsLoopback() && !addr.IsUnspecified()
+}
+
+// checkPIIValue сообщает о персональных данных в строковом значении поля
+func checkPIIValue(pass *analysis.Pass, key fieldKey, value string, cfg Config) {
+	if detector, ok := detectPII(value, cfg); ok {
+		reportf(pass, cfg, RuleSensitiveData, ast.Unparen(key.value).Pos(), "log field %q may contain personal data (%s)", key.name, detector)
+	}
+}
@@ -0,0 +1,60 @@
+package analyzer
+
+import "testing"
+
+func Test_detectPII(t *testing.T) {
+	all := PIIConfig{Email: true, IP: true, Phone: true, Card: true}
+	tests := []struct {
+		name         string
+		text         string
+		pii          PIIConfig
+		wantDetector string
+		wantOk       bool
+	}{
+		{name: "plain message", text: "user logged in", pii: all},
+		{name: "email", text: "sent to john.doe@example.com", pii: all, wantDetector: "email", wantOk: true},
+		{name: "email disabled", text: "sent to john.doe@example.com", pii: PIIConfig{IP: true}},
+		{name: "ipv4", text: "client 192.168.1.10 connected", pii: all, wantDetector: "ip-address", wantOk: true},
+		{name: "invalid ipv4", text: "version 1.2.300.4", pii: all},
+		{name: "loopback", text: "listening on 127.0.0.1:8080", pii: all},
+		{name: "unspecified", text: "listening on 0.0.0.0", pii: all},
+		{name: "ipv6", text: "client 2001:db8::8a2e:370:7334 connected", pii: all, wantDetector: "ip-address", wantOk: true},
+		{name: "ipv6 loopback", text: "listening on ::1", pii: all},
+		{name: "time is not ipv6", text: "started at 12:30:45", pii: all},
+		{name: "e164 phone", text: "call +14155552671", pii: all, wantDetector: "phone-number", wantOk: true},
+		{name: "us phone", text: "call (415) 555-2671", pii: all, wantDetector: "phone-number", wantOk: true},
+		{name: "ru phone", text: "call 8 (999) 123-45-67", pii: all, wantDetector: "phone-number", wantOk: true},
+		{name: "date is not phone", text: "released 2024-01-15", pii: all},
+		{name: "phone disabled", text: "call +14155552671", pii: PIIConfig{Email: true}},
+		{name: "card", text: "charged 4111 1111 1111 1111", pii: all, wantDetector: "card-number", wantOk: true},
+		{name: "card failing luhn", text: "order 4111111111111112", pii: all},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector, ok := detectPII(tt.text, Config{PII: tt.pii})
+			if ok != tt.wantOk || detector != tt.wantDetector {
+				t.Errorf("detectPII() = (%q, %v), want (%q, %v)", detector, ok, tt.wantDetector, tt.wantOk)
+			}
+		})
+	}
+}
+
+func Test_luhnValid(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{name: "visa test card", s: "4111111111111111", want: true},
+		{name: "with separators", s: "5500-0000-0000-0004", want: true},
+		{name: "wrong check digit", s: "4111111111111112", want: false},
+		{name: "too short", s: "42", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := luhnValid(tt.s); got != tt.want {
+				t.Errorf("luhnValid(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// formatVerb — директива строки формата: %d, %-5s, %[2]*.[1]f, %%
+type formatVerb struct {
+	verb  rune   // 0, если строка формата закончилась раньше глагола
+	text  string // директива целиком
+	start int    // смещение директивы в строке формата
+	end   int
+	arg   int   // индекс операнда глагола, -1 для %%
+	stars []int // индексы операндов ширины и точности, заданных через *
+}
+
+// parseFormat разбирает строку формата на директивы по правилам пакета fmt.
+// reordered — в формате есть явные индексы операндов: %[2]d.
+func parseFormat(format string) (verbs []formatVerb, reordered bool) {
+	argNum := 0
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			i++
+			continue
+		}
+		v := formatVerb{start: i, arg: -1}
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0", format[i]) >= 0 {
+			i++
+		}
+		parseIndex := func() {
+			if i >= len(format) || format[i] != '[' {
+				return
+			}
+			j := strings.IndexByte(format[i:], ']')
+			if j < 0 {
+				return
+			}
+			if n, err := strconv.Atoi(format[i+1 : i+j]); err == nil && n > 0 {
+				argNum = n - 1
+				reordered = true
+			}
+			i += j + 1
+		}
+		parseNum := func() {
+			parseIndex()
+			if i < len(format) && format[i] == '*' {
+				v.stars = append(v.stars, argNum)
+				argNum++
+				i++
+				return
+			}
+			for i < len(format) && format[i] >= '0' && format[i] <= '9' {
+				i++
+			}
+		}
+		parseNum() // ширина
+		if i < len(format) && format[i] == '.' {
+			i++
+			parseNum() // точность
+		}
+		parseIndex()
+		if i < len(format) {
+			r, size := utf8.DecodeRuneInString(format[i:])
+			v.verb = r
+			i += size
+			if r != '%' {
+				v.arg = argNum
+				argNum++
+			}
+		}
+		v.end = i
+		v.text = format[v.start:v.end]
+		verbs = append(verbs, v)
+	}
+	return verbs, reordered
+}
+
+// stripFormatVerbs удаляет из строки формата директивы, чтобы проверять стиль только текста сообщения
+func stripFormatVerbs(format string) string {
+	return fixFormatText(format, func(_ bool, text string) string { return text }, false)
+}
+
+// fixFormatText применяет fix к фрагментам текста между директивами.
+// first сообщает, что фрагмент стоит в самом начале строки формата.
+// Если keepVerbs установлен, директивы сохраняются, иначе удаляются.
+func fixFormatText(format string, fix func(first bool, text string) string, keepVerbs bool) string {
+	var b strings.Builder
+	verbs, _ := parseFormat(format)
+	prev := 0
+	for _, v := range verbs {
+		b.WriteString(fix(prev == 0, format[prev:v.start]))
+		if keepVerbs {
+			b.WriteString(v.text)
+		}
+		prev = v.end
+	}
+	b.WriteString(fix(prev == 0, format[prev:]))
+	return b.String()
+}
+
+// formatString возвращает строку формата, если она известна целиком: литерал, константа
+// или строковая переменная пакета, инициализированная литералом
+func formatString(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return constant.StringVal(tv.Value), true
+	}
+	if msg, _, ok := packageVarMessage(pass, expr); ok {
+		return msg, true
+	}
+	return "", false
+}
+
+// checkPrintfCall проверяет соответствие директив строки формата аргументам вызова, как vet printf
+func checkPrintfCall(pass *analysis.Pass, callExpr *ast.CallExpr, logger *LoggerConfig, cfg Config) {
+	format, ok := formatString(pass, callExpr.Args[logger.MessageIndex])
+	if !ok {
+		return
+	}
+	args := callExpr.Args[logger.MessageIndex+1:]
+	verbs, reordered := parseFormat(format)
+	maxArg := 0
+	for _, v := range verbs {
+		switch {
+		case v.verb == '%':
+			continue
+		case v.verb == 0:
+			reportf(pass, cfg, RulePrintf, callExpr.Pos(), "log format %q is missing verb at end of string", format)
+			return
+		case v.verb == 'w':
+			maxArg = max(maxArg, v.arg+1)
+			reportf(pass, cfg, RulePrintf, callExpr.Pos(), "log format %s uses error-wrapping directive %%w, supported only by fmt.Errorf", v.text)
+			continue
+		}
+		if _, ok := printfVerbs[v.verb]; !ok {
+			maxArg = max(maxArg, v.arg+1)
+			reportf(pass, cfg, RulePrintf, callExpr.Pos(), "log format %s has unknown verb %c", v.text, v.verb)
+			continue
+		}
+		for _, star := range v.stars {
+			maxArg = max(maxArg, star+1)
+			if !checkPrintfArg(pass, callExpr, v, star, args, 0, cfg) {
+				return
+			}
+		}
+		maxArg = max(maxArg, v.arg+1)
+		if !checkPrintfArg(pass, callExpr, v, v.arg, args, v.verb, cfg) {
+			return
+		}
+	}
+	if callExpr.Ellipsis.IsValid() || reordered {
+		return
+	}
+	if maxArg < len(args) {
+		reportf(pass, cfg, RulePrintf, callExpr.Pos(), "log call needs %d args but has %d args", maxArg, len(args))
+	}
+}
+
+// checkPrintfArg проверяет наличие и тип операнда директивы; verb == 0 — операнд ширины или точности.
+// Возвращает false, если дальше операнды проверить нельзя.
+func checkPrintfArg(pass *analysis.Pass, callExpr *ast.CallExpr, v formatVerb, argIdx int, args []ast.Expr, verb rune, cfg Config) bool {
+	if callExpr.Ellipsis.IsValid() && argIdx >= len(args)-1 {
+		// операнды переданы срезом: args...
+		return false
+	}
+	if argIdx >= len(args) {
+		reportf(pass, cfg, RulePrintf, callExpr.Pos(), "log format %s reads arg #%d, but call has %d args", v.text, argIdx+1, len(args))
+		return false
+	}
+	arg := args[argIdx]
+	typ := pass.TypesInfo.TypeOf(arg)
+	if typ == nil {
+		return true
+	}
+	if verb == 0 {
+		if basic, ok := typ.Underlying().(*types.Basic); !ok || basic.Info()&types.IsInteger == 0 {
+			reportf(pass, cfg, RulePrintf, arg.Pos(), "log format %s uses non-int %s as argument of *", v.text, types.ExprString(arg))
+		}
+		return true
+	}
+	if !matchArgType(printfVerbs[verb], typ, make(map[types.Type]bool)) {
+		reportf(pass, cfg, RulePrintf, arg.Pos(), "log format %s has arg %s of wrong type %s", v.text, types.ExprString(arg), typ)
+	}
+	return true
+}
+
+// argKind — набор видов операндов, допустимых для глагола
+type argKind int
+
+const (
+	argBool argKind = 1 << iota
+	argInt
+	argFloat
+	argComplex
+	argString
+	argPointer
+	argError // error и fmt.Stringer
+	argAny   = argBool | argInt | argFloat | argComplex | argString | argPointer | argError
+)
+
+// printfVerbs — допустимые виды операндов для глаголов пакета fmt
+var printfVerbs = map[rune]argKind{
+	'v': argAny,
+	'T': argAny,
+	't': argBool,
+	'b': argInt | argFloat | argComplex | argPointer,
+	'c': argInt,
+	'd': argInt | argPointer,
+	'o': argInt | argPointer,
+	'O': argInt | argPointer,
+	'U': argInt,
+	'q': argInt | argString | argError,
+	'x': argInt | argFloat | argComplex | argString | argPointer | argError,
+	'X': argInt | argFloat | argComplex | argString | argPointer | argError,
+	'e': argFloat | argComplex,
+	'E': argFloat | argComplex,
+	'f': argFloat | argComplex,
+	'F': argFloat | argComplex,
+	'g': argFloat | argComplex,
+	'G': argFloat | argComplex,
+	's': argString | argError,
+	'p': argPointer,
+}
+
+// matchArgType проверяет, что тип операнда подходит для глагола.
+// Составные типы проверяются поэлементно: fmt применяет глагол к каждому элементу.
+func matchArgType(kinds argKind, typ types.Type, seen map[types.Type]bool) bool {
+	if kinds == argAny || seen[typ] {
+		return true
+	}
+	seen[typ] = true
+	if hasMethod(typ, "Format") {
+		return true
+	}
+	if kinds&argError != 0 && (hasMethod(typ, "Error") || hasMethod(typ, "String")) {
+		return true
+	}
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return kinds&argBool != 0
+		case t.Info()&types.IsInteger != 0:
+			return kinds&argInt != 0
+		case t.Info()&types.IsFloat != 0:
+			return kinds&argFloat != 0
+		case t.Info()&types.IsComplex != 0:
+			return kinds&argComplex != 0
+		case t.Info()&types.IsString != 0:
+			return kinds&argString != 0
+		case t.Kind() == types.UnsafePointer, t.Kind() == types.UntypedNil:
+			return kinds&argPointer != 0
+		}
+		return true
+	case *types.Interface:
+		// динамический тип неизвестен
+		return true
+	case *types.Pointer:
+		if kinds&argPointer != 0 {
+			return true
+		}
+		// указатель на структуру fmt печатает как &{...}
+		if _, ok := t.Elem().Underlying().(*types.Struct); ok {
+			return matchArgType(kinds, t.Elem(), seen)
+		}
+		return false
+	case *types.Chan, *types.Signature:
+		return kinds&argPointer != 0
+	case *types.Map:
+		return kinds&argPointer != 0 || matchArgType(kinds, t.Key(), seen) && matchArgType(kinds, t.Elem(), seen)
+	case *types.Slice:
+		if isByteType(t.Elem()) && kinds&argString != 0 {
+			return true
+		}
+		return kinds&argPointer != 0 || matchArgType(kinds, t.Elem(), seen)
+	case *types.Array:
+		if isByteType(t.Elem()) && kinds&argString != 0 {
+			return true
+		}
+		return matchArgType(kinds, t.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if !matchArgType(kinds, t.Field(i).Type(), seen) {
+				return false
+			}
+		}
+		return true
+	}
+	return true
+}
+
+// hasMethod проверяет, что у типа или указателя на него есть метод с указанным именем
+func hasMethod(typ types.Type, name string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
+
+func isByteType(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Kind() == types.Byte
+}
+
+// possibleFormatVerb — директивы форматирования, которые по ошибке передают в методы без форматирования.
+// Флаги пробела и нуля не учитываются, чтобы не срабатывать на текст вроде "50% done".
+var possibleFormatVerb = regexp.MustCompile(`%[+\-#]*(\[\d+\])?(\*|\d+)?\.?(\*|\d+)?(\[\d+\])?[bcdefgopqstvxEFGTUX]`)
+
+// checkNonPrintfCall сообщает о директивах форматирования в сообщении метода, который не форматирует строку:
+// slog.Info("user %s", u). Возвращает true, если директива найдена.
+func checkNonPrintfCall(pass *analysis.Pass, callExpr *ast.CallExpr, message string, cfg Config) bool {
+	verb := possibleFormatVerb.FindString(message)
+	if verb == "" {
+		return false
+	}
+	name := "log call"
+	if fn, ok := calledFunc(pass, callExpr); ok {
+		name = fn.Name()
+	}
+	reportf(pass, cfg, RulePrintf, callExpr.Pos(), "log message contains formatting directive %s, but %s is not a printf-style method", verb, name)
+	return true
+}
@@ -0,0 +1,71 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_parseFormat(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        string
+		wantVerbs     []string
+		wantArgs      []int
+		wantReordered bool
+	}{
+		{name: "no verbs", format: "server started"},
+		{name: "simple verbs", format: "user %s took %d ms", wantVerbs: []string{"%s", "%d"}, wantArgs: []int{0, 1}},
+		{name: "percent", format: "progress %d%%", wantVerbs: []string{"%d", "%%"}, wantArgs: []int{0, -1}},
+		{name: "flags and precision", format: "took %-8.2f s", wantVerbs: []string{"%-8.2f"}, wantArgs: []int{0}},
+		{name: "star width", format: "padded %*d", wantVerbs: []string{"%*d"}, wantArgs: []int{1}},
+		{name: "explicit index", format: "%[2]d %[1]s", wantVerbs: []string{"%[2]d", "%[1]s"}, wantArgs: []int{1, 0}, wantReordered: true},
+		{name: "missing verb", format: "progress 100%", wantVerbs: []string{"%"}, wantArgs: []int{-1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verbs, reordered := parseFormat(tt.format)
+			if len(verbs) != len(tt.wantVerbs) {
+				t.Fatalf("parseFormat(%q) returned %d verbs, want %d", tt.format, len(verbs), len(tt.wantVerbs))
+			}
+			for i, v := range verbs {
+				if v.text != tt.wantVerbs[i] || v.arg != tt.wantArgs[i] {
+					t.Errorf("verb %d = %q (arg %d), want %q (arg %d)", i, v.text, v.arg, tt.wantVerbs[i], tt.wantArgs[i])
+				}
+			}
+			if reordered != tt.wantReordered {
+				t.Errorf("reordered = %v, want %v", reordered, tt.wantReordered)
+			}
+		})
+	}
+}
+
+func Test_fixFormatText(t *testing.T) {
+	upper := func(first bool, text string) string {
+		if first {
+			return strings.ToUpper(text)
+		}
+		return text
+	}
+	tests := []struct {
+		name      string
+		format    string
+		keepVerbs bool
+		want      string
+	}{
+		{name: "strip verbs", format: "request %s took %d ms", want: "request  took  ms"},
+		{name: "strip percent", format: "progress %d%%", want: "progress "},
+		{name: "keep verbs", format: "request %s took %d ms", keepVerbs: true, want: "REQUEST %s took %d ms"},
+		{name: "verb at start", format: "%s started", keepVerbs: true, want: "%s started"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fix := func(_ bool, text string) string { return text }
+			if tt.keepVerbs {
+				fix = upper
+			}
+			if got := fixFormatText(tt.format, fix, tt.keepVerbs); got != tt.want {
+				t.Errorf("fixFormatText(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
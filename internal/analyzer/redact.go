@@ -0,0 +1,155 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// parseRedactor разбирает настройку redactor: "corp/redact.String" -> ("corp/redact", "String")
+func parseRedactor(redactor string) (pkgPath, fn string, err error) {
+	i := strings.LastIndex(redactor, ".")
+	if i <= 0 || i == len(redactor)-1 || strings.HasSuffix(redactor[:i], "/") {
+		return "", "", fmt.Errorf("invalid redactor %q: want import path and function name, e.g. corp/redact.String", redactor)
+	}
+	return redactor[:i], redactor[i+1:], nil
+}
+
+// findPackage ищет пакет среди зависимостей анализируемого пакета
+func findPackage(pkg *types.Package, pkgPath string) *types.Package {
+	seen := make(map[*types.Package]bool)
+	queue := []*types.Package{pkg}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if p.Path() == pkgPath {
+			return p
+		}
+		for _, imp := range p.Imports() {
+			if !seen[imp] {
+				seen[imp] = true
+				queue = append(queue, imp)
+			}
+		}
+	}
+	return nil
+}
+
+// redactFix предлагает обернуть значения в функцию из настройки redactor и при необходимости
+// добавляет импорт её пакета:
+//
+//	zap.String("password", p) -> zap.String("password", redact.String(p))
+//
+// Оборачиваются только значения, тип которых после правки не изменится. Если пакет функции
+// недоступен анализатору, оборачиваются только значения типа string.
+func redactFix(pass *analysis.Pass, values []ast.Expr, cfg Config) []analysis.SuggestedFix {
+	if cfg.Redactor == "" || len(values) == 0 {
+		return nil
+	}
+	pkgPath, fnName, err := parseRedactor(cfg.Redactor)
+	if err != nil {
+		return nil
+	}
+	var sig *types.Signature
+	name := path.Base(pkgPath)
+	if pkg := findPackage(pass.Pkg, pkgPath); pkg != nil {
+		fn, ok := pkg.Scope().Lookup(fnName).(*types.Func)
+		if !ok {
+			return nil
+		}
+		sig = fn.Signature()
+		name = pkg.Name()
+	}
+	values = slices.DeleteFunc(slices.Clone(values), func(value ast.Expr) bool {
+		return !redactable(pass.TypesInfo.TypeOf(value), sig)
+	})
+	if len(values) == 0 {
+		return nil
+	}
+	file := fileOf(pass, values[0].Pos())
+	if file == nil {
+		return nil
+	}
+	var edits []analysis.TextEdit
+	if imported := importName(pass, values[0].Pos(), pkgPath); imported != "" {
+		name = imported
+	} else {
+		edits = append(edits, addImportEdit(file, pkgPath))
+	}
+	for _, value := range values {
+		edits = append(edits,
+			analysis.TextEdit{Pos: value.Pos(), End: value.Pos(), NewText: []byte(name + "." + fnName + "(")},
+			analysis.TextEdit{Pos: value.End(), End: value.End(), NewText: []byte(")")})
+	}
+	return []analysis.SuggestedFix{{
+		Message:   fmt.Sprintf("wrap sensitive value in %s.%s", name, fnName),
+		TextEdits: edits,
+	}}
+}
+
+// isRedacted проверяет, что значение уже обёрнуто в функцию из настройки redactor
+func isRedacted(pass *analysis.Pass, expr ast.Expr, cfg Config) bool {
+	if cfg.Redactor == "" {
+		return false
+	}
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := calledFunc(pass, call)
+	return ok && fn.Pkg() != nil && fn.Signature().Recv() == nil && fn.Pkg().Path()+"."+fn.Name() == cfg.Redactor
+}
+
+// redactable проверяет, что значение можно передать в функцию редактирования и что её результат
+// имеет тот же тип
+func redactable(typ types.Type, sig *types.Signature) bool {
+	if typ == nil {
+		return false
+	}
+	typ = types.Default(typ)
+	if sig == nil {
+		return types.Identical(typ, types.Typ[types.String])
+	}
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 || sig.Variadic() {
+		return false
+	}
+	return types.AssignableTo(typ, sig.Params().At(0).Type()) && types.Identical(sig.Results().At(0).Type(), typ)
+}
+
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, f := range pass.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			return f
+		}
+	}
+	return nil
+}
+
+// addImportEdit добавляет импорт пакета в файл: в первый блок импортов, одиночный импорт
+// превращается в блок, а файл без импортов получает импорт после объявления пакета
+func addImportEdit(file *ast.File, pkgPath string) analysis.TextEdit {
+	quoted := strconv.Quote(pkgPath)
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		if gd.Lparen.IsValid() {
+			return analysis.TextEdit{Pos: gd.Lparen + 1, End: gd.Lparen + 1, NewText: []byte("\n\t" + quoted)}
+		}
+		spec := gd.Specs[0].(*ast.ImportSpec)
+		existing := spec.Path.Value
+		if spec.Name != nil {
+			existing = spec.Name.Name + " " + existing
+		}
+		return analysis.TextEdit{Pos: gd.Pos(), End: gd.End(), NewText: []byte("import (\n\t" + existing + "\n\t" + quoted + "\n)")}
+	}
+	return analysis.TextEdit{Pos: file.Name.End(), End: file.Name.End(), NewText: []byte("\n\nimport " + quoted)}
+}
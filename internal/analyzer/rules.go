@@ -0,0 +1,135 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Идентификаторы правил. Идентификатор правила попадает в Category диагностики.
+const (
+	RuleLowercaseStart    = "lowercase-start"
+	RuleEnglishOnly       = "english-only"
+	RuleSensitiveData     = "sensitive-data"
+	RuleDisallowedSymbols = "disallowed-symbols"
+	// RuleHardcodedSecret — секреты в тексте сообщения и в строковых значениях полей
+	RuleHardcodedSecret = "hardcoded-secret"
+	// RuleSensitiveValue — значения из переменных, полей и методов с чувствительными именами
+	RuleSensitiveValue = "sensitive-value"
+	// RulePrintf — строки формата printf-style методов и директивы форматирования в обычных методах
+	RulePrintf = "printf"
+	// RuleStructuredMessage — сообщение, собранное конкатенацией или fmt.Sprintf, вместо структурированных полей
+	RuleStructuredMessage = "structured-message"
+	// RuleKeyStyle — стиль ключей структурированных полей (Config.KeyStyle)
+	RuleKeyStyle = "key-style"
+	// RuleDuplicateKeys — повторяющиеся ключи полей в вызове и среди полей, привязанных через With
+	RuleDuplicateKeys = "duplicate-keys"
+	// RuleKVPairs — несбалансированные пары ключ/значение и ключи не строкового типа
+	RuleKVPairs = "kv-pairs"
+	// RuleDirectives — проблемы в директивах подавления //prettyloglint:ignore
+	RuleDirectives = "directives"
+)
+
+// Severity — уровень важности диагностики правила
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// RuleConfig — настройки отдельного правила.
+// Правило включено, если Enabled не задан. Уровень по умолчанию — error;
+// для остальных уровней сообщение диагностики начинается с префикса "warning: " или "info: ".
+type RuleConfig struct {
+	Enabled  *bool    `yaml:"enabled"`
+	Severity Severity `yaml:"severity"`
+}
+
+// knownRules — все правила линтера
+var knownRules = map[string]bool{
+	RuleLowercaseStart:    true,
+	RuleEnglishOnly:       true,
+	RuleSensitiveData:     true,
+	RuleHardcodedSecret:   true,
+	RuleSensitiveValue:    true,
+	RuleDisallowedSymbols: true,
+	RulePrintf:            true,
+	RuleStructuredMessage: true,
+	RuleKeyStyle:          true,
+	RuleDuplicateKeys:     true,
+	RuleKVPairs:           true,
+	RuleDirectives:        true,
+}
+
+// Validate проверяет настройки: правила, стиль ключей, шаблоны чувствительных данных, функцию redactor, описания логеров и порог энтропии
+func (cfg Config) Validate() error {
+	return cfg.prepare()
+}
+
+// prepare проверяет настройки, компилирует стиль ключей и шаблоны чувствительных данных
+// и разбивает безопасные термины на слова
+func (cfg *Config) prepare() error {
+	var err error
+	if cfg.keyStyle, err = compileKeyStyle(cfg.KeyStyle); err != nil {
+		return err
+	}
+	if cfg.patterns, err = compilePatterns(*cfg); err != nil {
+		return err
+	}
+	cfg.safeWords = append(splitAll(cfg.SafeKeys), safeTermWords...)
+	if cfg.Redactor != "" {
+		if _, _, err := parseRedactor(cfg.Redactor); err != nil {
+			return err
+		}
+	}
+	for i, l := range cfg.Loggers {
+		if err := l.validate(); err != nil {
+			return fmt.Errorf("loggers[%d] (%s): %w", i, l.Package, err)
+		}
+	}
+	if cfg.EntropyThreshold < 0 {
+		return fmt.Errorf("entropy-threshold should not be negative, got %v", cfg.EntropyThreshold)
+	}
+	ids := make([]string, 0, len(cfg.Rules))
+	for id := range cfg.Rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if !knownRules[id] {
+			return fmt.Errorf("unknown rule %q", id)
+		}
+		switch cfg.Rules[id].Severity {
+		case "", SeverityError, SeverityWarning, SeverityInfo:
+		default:
+			return fmt.Errorf("rule %q: unknown severity %q", id, cfg.Rules[id].Severity)
+		}
+	}
+	return nil
+}
+
+func (cfg Config) ruleEnabled(rule string) bool {
+	rc, ok := cfg.Rules[rule]
+	return !ok || rc.Enabled == nil || *rc.Enabled
+}
+
+// report отправляет диагностику правила с учётом его настроек
+func report(pass *analysis.Pass, cfg Config, rule string, d analysis.Diagnostic) {
+	if !cfg.ruleEnabled(rule) {
+		return
+	}
+	d.Category = rule
+	if sev := cfg.Rules[rule].Severity; sev != "" && sev != SeverityError {
+		d.Message = string(sev) + ": " + d.Message
+	}
+	pass.Report(d)
+}
+
+// reportf — аналог pass.Reportf для диагностики правила
+func reportf(pass *analysis.Pass, cfg Config, rule string, pos token.Pos, format string, args ...interface{}) {
+	report(pass, cfg, rule, analysis.Diagnostic{Pos: pos, Message: fmt.Sprintf(format, args...)})
+}
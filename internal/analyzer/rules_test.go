@@ -0,0 +1,130 @@
+package analyzer
+
+import "testing"
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    map[string]RuleConfig
+		keyStyle string
+		redactor string
+		custom   []string
+		patterns []SensitivePattern
+		loggers  []LoggerConfig
+		wantErr  bool
+	}{
+		{
+			name:    "no rules",
+			rules:   nil,
+			wantErr: false,
+		},
+		{
+			name:    "known rules",
+			rules:   map[string]RuleConfig{RuleEnglishOnly: {Severity: SeverityWarning}, RuleSensitiveData: {}},
+			wantErr: false,
+		},
+		{
+			name:    "unknown rule",
+			rules:   map[string]RuleConfig{"no-such-rule": {}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown severity",
+			rules:   map[string]RuleConfig{RuleLowercaseStart: {Severity: "fatal"}},
+			wantErr: true,
+		},
+		{
+			name:     "named key style",
+			keyStyle: KeyStyleCamelCase,
+			wantErr:  false,
+		},
+		{
+			name:     "invalid key style pattern",
+			keyStyle: `^[a-z`,
+			wantErr:  true,
+		},
+		{
+			name:     "redactor",
+			redactor: "corp/redact.String",
+			wantErr:  false,
+		},
+		{
+			name:     "redactor without package",
+			redactor: "String",
+			wantErr:  true,
+		},
+		{
+			name:     "redactor without function",
+			redactor: "corp/redact.",
+			wantErr:  true,
+		},
+		{
+			name:    "custom sensitive pattern",
+			custom:  []string{`^api_`},
+			wantErr: false,
+		},
+		{
+			name:    "invalid custom sensitive pattern",
+			custom:  []string{`(secret`},
+			wantErr: true,
+		},
+		{
+			name:     "sensitive pattern with options",
+			patterns: []SensitivePattern{{Name: "ssn", Pattern: `\d{3}-\d{2}-\d{4}`, CaseSensitive: true, Targets: []string{PatternTargetValue}}},
+			wantErr:  false,
+		},
+		{
+			name:     "invalid sensitive pattern",
+			patterns: []SensitivePattern{{Pattern: `[a-`}},
+			wantErr:  true,
+		},
+		{
+			name:     "empty sensitive pattern",
+			patterns: []SensitivePattern{{Name: "empty"}},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown pattern target",
+			patterns: []SensitivePattern{{Pattern: `tenant`, Targets: []string{"header"}}},
+			wantErr:  true,
+		},
+		{
+			name:    "logger",
+			loggers: []LoggerConfig{{Package: "corp/logging", Type: "Logger", Methods: []string{"With"}, MessageIndex: -1, Fields: FieldsKeyValue}},
+			wantErr: false,
+		},
+		{
+			name:    "logger with default fields",
+			loggers: []LoggerConfig{{Package: "corp/logging", Methods: []string{"Event"}}},
+			wantErr: false,
+		},
+		{
+			name:    "logger with negative message index",
+			loggers: []LoggerConfig{{Package: "corp/logging", Methods: []string{"Event"}, MessageIndex: -2}},
+			wantErr: true,
+		},
+		{
+			name:    "logger with unknown fields",
+			loggers: []LoggerConfig{{Package: "corp/logging", Methods: []string{"Event"}, Fields: "bogus"}},
+			wantErr: true,
+		},
+		{
+			name:    "logger without package",
+			loggers: []LoggerConfig{{Methods: []string{"Event"}}},
+			wantErr: true,
+		},
+		{
+			name:    "logger without methods",
+			loggers: []LoggerConfig{{Package: "corp/logging"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Rules: tt.rules, KeyStyle: tt.keyStyle, Redactor: tt.redactor, CustomSensitivePatterns: tt.custom, SensitivePatterns: tt.patterns, Loggers: tt.loggers}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// secretDetector находит в тексте секрет известного вида
+type secretDetector struct {
+	name string
+	re   *regexp.Regexp
+}
+
+var secretDetectors = []secretDetector{
+	{name: "private-key", re: regexp.MustCompile(`-----BEGIN ([A-Z0-9]+ )*PRIVATE KEY( BLOCK)?-----`)},
+	{name: "jwt", re: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)},
+	{name: "aws-access-key", re: regexp.MustCompile(`\b(AKIA|ASIA|AGPA|AIDA|AROA|ANPA|ANVA)[0-9A-Z]{16}\b`)},
+	{name: "github-token", re: regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{name: "bearer-token", re: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]{20,}=*`)},
+}
+
+const (
+	// entropyDetector — имя эвристики по энтропии Шеннона
+	entropyDetector = "high-entropy"
+	// minEntropyTokenLen — минимальная длина слова для проверки энтропии
+	minEntropyTokenLen = 20
+)
+
+// detectSecret возвращает имя детектора, нашедшего секрет в тексте.
+// Эвристика по энтропии применяется к словам не короче minEntropyTokenLen, содержащим буквы и цифры,
+// если Config.EntropyThreshold больше нуля.
+func detectSecret(text string, cfg Config) (string, bool) {
+	for _, d := range secretDetectors {
+		if d.re.MatchString(text) {
+			return d.name, true
+		}
+	}
+	if cfg.EntropyThreshold <= 0 {
+		return "", false
+	}
+	for _, word := range strings.FieldsFunc(text, isSecretSeparator) {
+		if len(word) >= minEntropyTokenLen && hasLettersAndDigits(word) && shannonEntropy(word) >= cfg.EntropyThreshold {
+			return entropyDetector, true
+		}
+	}
+	return "", false
+}
+
+// isSecretSeparator разделяет слова текста; символы base64 (+-_=) разделителями не являются.
+// "/" и "." разделяют элементы путей, доменов и версий: github.com/org/repo/v2 — не одно длинное слово
+func isSecretSeparator(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune("\"'`,;:()[]{}<>/.", r)
+}
+
+func hasLettersAndDigits(s string) bool {
+	var letter, digit bool
+	for _, r := range s {
+		letter = letter || unicode.IsLetter(r)
+		digit = digit || unicode.IsDigit(r)
+	}
+	return letter && digit
+}
+
+// shannonEntropy возвращает энтропию Шеннона строки в битах на символ
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	var entropy float64
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// fieldStringValue возвращает строковое значение поля, известное на этапе компиляции
+func fieldStringValue(pass *analysis.Pass, key fieldKey) (string, bool) {
+	if key.value == nil {
+		return "", false
+	}
+	tv, ok := pass.TypesInfo.Types[key.value]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// checkSecretValue сообщает о секрете в строковом значении поля
+func checkSecretValue(pass *analysis.Pass, key fieldKey, value string, cfg Config) {
+	if detector, ok := detectSecret(value, cfg); ok {
+		reportf(pass, cfg, RuleHardcodedSecret, ast.Unparen(key.value).Pos(), "log field %q contains a hardcoded secret (%s)", key.name, detector)
+	}
+}
@@ -0,0 +1,171 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// sensitiveFact помечает тип, значение которого нельзя выводить в лог целиком: тип указан
+// в настройке sensitive-types или содержит поле с тегом log:"sensitive" (log:"redact").
+// Факт позволяет распознавать такие типы из других пакетов.
+type sensitiveFact struct {
+	Field string // путь до чувствительного поля: "Password", "Auth.Token"; пусто, если чувствителен сам тип
+}
+
+func (*sensitiveFact) AFact() {}
+
+func (f *sensitiveFact) String() string {
+	if f.Field == "" {
+		return "sensitiveType"
+	}
+	return fmt.Sprintf("sensitiveType(field=%s)", f.Field)
+}
+
+// sensitiveTypes определяет чувствительные типы анализируемого пакета и его зависимостей
+type sensitiveTypes struct {
+	pass       *analysis.Pass
+	configured map[string]bool                    // типы из настройки sensitive-types: "corp/secrets.Token"
+	local      map[*types.TypeName]*sensitiveFact // результаты для типов пакета; nil — тип не чувствителен
+}
+
+func newSensitiveTypes(pass *analysis.Pass, cfg Config) *sensitiveTypes {
+	s := &sensitiveTypes{
+		pass:       pass,
+		configured: make(map[string]bool, len(cfg.SensitiveTypes)),
+		local:      make(map[*types.TypeName]*sensitiveFact),
+	}
+	for _, name := range cfg.SensitiveTypes {
+		s.configured[name] = true
+	}
+	return s
+}
+
+// exportFacts экспортирует факты для чувствительных типов уровня пакета
+func (s *sensitiveTypes) exportFacts() {
+	scope := s.pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+		if fact, ok := s.reason(obj.Type()); ok {
+			s.pass.ExportObjectFact(obj, fact)
+		}
+	}
+}
+
+// isSensitiveTag проверяет тег поля: log:"sensitive" или log:"redact"
+func isSensitiveTag(tag string) bool {
+	switch reflect.StructTag(tag).Get("log") {
+	case "sensitive", "redact":
+		return true
+	}
+	return false
+}
+
+// selfRedacting проверяет, что тип сам управляет своим представлением в логе:
+// String(), slog.LogValuer или zapcore.ObjectMarshaler
+func selfRedacting(typ types.Type) bool {
+	return hasMethod(typ, "String") || hasMethod(typ, "LogValue") || hasMethod(typ, "MarshalLogObject")
+}
+
+// reason сообщает, является ли тип чувствительным, и возвращает путь до чувствительного поля.
+// Указатели, срезы, массивы и map чувствительны, если чувствителен тип их элементов.
+func (s *sensitiveTypes) reason(typ types.Type) (*sensitiveFact, bool) {
+	switch t := types.Unalias(typ).(type) {
+	case *types.Named:
+		obj := t.Origin().Obj()
+		if obj.Pkg() == nil || selfRedacting(t) {
+			return nil, false
+		}
+		if s.configured[obj.Pkg().Path()+"."+obj.Name()] {
+			return &sensitiveFact{}, true
+		}
+		if obj.Pkg() != s.pass.Pkg {
+			fact := new(sensitiveFact)
+			return fact, s.pass.ImportObjectFact(obj, fact)
+		}
+		fact, done := s.local[obj]
+		if !done {
+			// рекурсивные типы: во время проверки тип считается нечувствительным
+			s.local[obj] = nil
+			fact, _ = s.reason(t.Underlying())
+			s.local[obj] = fact
+		}
+		return fact, fact != nil
+	case *types.Pointer:
+		return s.reason(t.Elem())
+	case *types.Slice:
+		return s.reason(t.Elem())
+	case *types.Array:
+		return s.reason(t.Elem())
+	case *types.Map:
+		return s.reason(t.Elem())
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			field := t.Field(i)
+			if isSensitiveTag(t.Tag(i)) {
+				return &sensitiveFact{Field: field.Name()}, true
+			}
+			if inner, ok := s.reason(field.Type()); ok {
+				path := field.Name()
+				if inner.Field != "" {
+					path += "." + inner.Field
+				}
+				return &sensitiveFact{Field: path}, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// check сообщает о значении чувствительного типа. Возвращает true, если сообщение отправлено.
+// what описывает место значения в вызове: "log message" или `log field "user"`.
+func (s *sensitiveTypes) check(expr ast.Expr, what string, cfg Config) bool {
+	if s == nil {
+		return false
+	}
+	typ := s.pass.TypesInfo.TypeOf(expr)
+	if typ == nil {
+		return false
+	}
+	fact, ok := s.reason(typ)
+	if !ok {
+		return false
+	}
+	typeName := types.TypeString(typ, func(p *types.Package) string {
+		if p == s.pass.Pkg {
+			return ""
+		}
+		return p.Name()
+	})
+	if fact.Field == "" {
+		reportf(s.pass, cfg, RuleSensitiveValue, expr.Pos(), "%s value %s has sensitive type %s", what, types.ExprString(expr), typeName)
+	} else {
+		reportf(s.pass, cfg, RuleSensitiveValue, expr.Pos(), "%s value %s of type %s contains sensitive field %s",
+			what, types.ExprString(expr), typeName, fact.Field)
+	}
+	return true
+}
+
+// formatValueArgs возвращает аргументы, которые строка формата выводит целиком: %v, %+v, %#v, %s, %q
+func formatValueArgs(pass *analysis.Pass, format ast.Expr, args []ast.Expr) []ast.Expr {
+	text, ok := formatString(pass, format)
+	if !ok {
+		return nil
+	}
+	var values []ast.Expr
+	verbs, _ := parseFormat(text)
+	for _, v := range verbs {
+		if strings.ContainsRune("vsq", v.verb) && v.arg >= 0 && v.arg < len(args) && !slices.Contains(values, args[v.arg]) {
+			values = append(values, args[v.arg])
+		}
+	}
+	return values
+}
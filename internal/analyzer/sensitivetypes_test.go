@@ -0,0 +1,25 @@
+package analyzer
+
+import "testing"
+
+func Test_isSensitiveTag(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+		want bool
+	}{
+		{name: "no tag", tag: "", want: false},
+		{name: "sensitive", tag: `log:"sensitive"`, want: true},
+		{name: "redact", tag: `log:"redact"`, want: true},
+		{name: "with other keys", tag: `json:"password" log:"sensitive"`, want: true},
+		{name: "other value", tag: `log:"omit"`, want: false},
+		{name: "other key", tag: `json:"sensitive"`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSensitiveTag(tt.tag); got != tt.want {
+				t.Errorf("isSensitiveTag(%q) = %v, want %v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// messagePart — часть сообщения, собранного конкатенацией или fmt.Sprintf:
+// постоянный текст или динамическое значение
+type messagePart struct {
+	text  string
+	value ast.Expr
+}
+
+// sprintfCall возвращает вызов fmt.Sprintf, если выражение им является
+func sprintfCall(pass *analysis.Pass, expr ast.Expr) (*ast.CallExpr, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return nil, false
+	}
+	fn, ok := calledFunc(pass, call)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" || fn.Name() != "Sprintf" {
+		return nil, false
+	}
+	return call, true
+}
+
+// dynamicMessageParts разбивает сообщение, собранное конкатенацией или fmt.Sprintf, на части.
+// dynamic сообщает, что сообщение строится во время выполнения; fixable — что его можно переписать
+// в постоянное сообщение с полями.
+func dynamicMessageParts(pass *analysis.Pass, expr ast.Expr) (parts []messagePart, dynamic, fixable bool) {
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+		return nil, false, false
+	}
+	if call, ok := sprintfCall(pass, expr); ok {
+		parts, ok := sprintfParts(pass, call)
+		return parts, true, ok
+	}
+	bin, ok := ast.Unparen(expr).(*ast.BinaryExpr)
+	if !ok || bin.Op != token.ADD || !isStringType(pass.TypesInfo.TypeOf(bin)) {
+		return nil, false, false
+	}
+	fixable = true
+	var walk func(e ast.Expr)
+	walk = func(e ast.Expr) {
+		e = ast.Unparen(e)
+		if tv, ok := pass.TypesInfo.Types[e]; ok && tv.Value != nil {
+			parts = append(parts, messagePart{text: constantString(tv)})
+			return
+		}
+		if b, ok := e.(*ast.BinaryExpr); ok && b.Op == token.ADD {
+			walk(b.X)
+			walk(b.Y)
+			return
+		}
+		// fmt.Sprintf внутри конкатенации разбивается так же, как сообщение целиком
+		if call, ok := sprintfCall(pass, e); ok {
+			if sub, ok := sprintfParts(pass, call); ok {
+				parts = append(parts, sub...)
+				return
+			}
+			fixable = false
+		}
+		parts = append(parts, messagePart{value: e})
+	}
+	walk(bin)
+	return parts, true, fixable
+}
+
+// sprintfParts разбивает вызов fmt.Sprintf на постоянный текст строки формата и аргументы.
+// Возвращает false, если формат неизвестен или директивы нельзя однозначно сопоставить с аргументами.
+func sprintfParts(pass *analysis.Pass, call *ast.CallExpr) ([]messagePart, bool) {
+	format, ok := formatString(pass, call.Args[0])
+	if !ok || call.Ellipsis.IsValid() {
+		return nil, false
+	}
+	args := call.Args[1:]
+	verbs, reordered := parseFormat(format)
+	var parts []messagePart
+	prev := 0
+	for _, v := range verbs {
+		if v.verb == '%' {
+			continue
+		}
+		if reordered || len(v.stars) > 0 || v.arg < 0 || v.arg >= len(args) {
+			return nil, false
+		}
+		parts = append(parts, messagePart{text: format[prev:v.start]}, messagePart{value: args[v.arg]})
+		prev = v.end
+	}
+	return append(parts, messagePart{text: format[prev:]}), true
+}
+
+func constantString(tv types.TypeAndValue) string {
+	s, err := strconv.Unquote(tv.Value.ExactString())
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// checkStructuredMessage сообщает о сообщении, собранном конкатенацией или fmt.Sprintf, в вызове логера
+// со структурированными полями и предлагает переписать его в постоянное сообщение с полями:
+//
+//	slog.Info("user " + id + " logged in") -> slog.Info("user logged in", slog.String("id", id))
+//
+// Возвращает правку, если её удалось построить.
+func checkStructuredMessage(pass *analysis.Pass, callExpr *ast.CallExpr, logger *LoggerConfig, cfg Config) (analysis.SuggestedFix, bool) {
+	if logger.Fields != FieldsKeyValue && logger.Fields != FieldsConstructors {
+		return analysis.SuggestedFix{}, false
+	}
+	msgExpr := callExpr.Args[logger.MessageIndex]
+	parts, dynamic, fixable := dynamicMessageParts(pass, msgExpr)
+	if !dynamic || !cfg.ruleEnabled(RuleStructuredMessage) {
+		return analysis.SuggestedFix{}, false
+	}
+	d := analysis.Diagnostic{
+		Pos:     msgExpr.Pos(),
+		End:     msgExpr.End(),
+		Message: "log message is built dynamically; use a constant message with structured fields",
+	}
+	var fix analysis.SuggestedFix
+	if fixable && !callExpr.Ellipsis.IsValid() {
+		if newText, ok := structuredMessageText(pass, callExpr, logger, parts, cfg); ok {
+			fix = analysis.SuggestedFix{
+				Message:   "rewrite log message with structured fields",
+				TextEdits: []analysis.TextEdit{{Pos: msgExpr.Pos(), End: msgExpr.End(), NewText: []byte(newText)}},
+			}
+			d.SuggestedFixes = []analysis.SuggestedFix{fix}
+		}
+	}
+	report(pass, cfg, RuleStructuredMessage, d)
+	return fix, len(d.SuggestedFixes) > 0
+}
+
+// structuredMessageText строит постоянное сообщение и поля, которые заменят выражение сообщения.
+// Постоянный текст исправляется по всем включённым правилам стиля.
+func structuredMessageText(pass *analysis.Pass, callExpr *ast.CallExpr, logger *LoggerConfig, parts []messagePart, cfg Config) (string, bool) {
+	var text strings.Builder
+	var values []ast.Expr
+	for _, p := range parts {
+		if p.value != nil {
+			values = append(values, p.value)
+			text.WriteString(" ")
+			continue
+		}
+		text.WriteString(p.text)
+	}
+	message := fixMessageText(text.String(), true, cfg)
+	message = strings.Trim(strings.Join(strings.Fields(message), " "), " :=,-")
+	if message == "" {
+		return "", false
+	}
+
+	// типизированные поля строятся функциями пакета логера, если он импортирован в файле
+	pkgName := ""
+	if logger.Package == slogPackage || logger.Package == zapPackage {
+		pkgName = importName(pass, callExpr.Pos(), logger.Package)
+	}
+	if pkgName == "" && logger.Fields == FieldsConstructors {
+		return "", false
+	}
+
+	res := []string{strconv.Quote(message)}
+	used := make(map[string]int)
+	for _, value := range values {
+		isErr := false
+		// err.Error() -> поле с самой ошибкой
+		if recv, ok := errorMethodReceiver(pass, value); ok {
+			value, isErr = recv, true
+		} else if typ := pass.TypesInfo.TypeOf(value); typ != nil && types.Implements(typ, errorType) {
+			isErr = true
+		}
+		key := "error"
+		if !isErr {
+			key = fieldKeyName(pass, value)
+		}
+		if used[key]++; used[key] > 1 {
+			key = fmt.Sprintf("%s_%d", key, used[key])
+		}
+		src, ok := exprSource(pass.Fset, value)
+		if !ok {
+			return "", false
+		}
+		if pkgName == "" {
+			res = append(res, strconv.Quote(key), src)
+			continue
+		}
+		res = append(res, typedField(pass, logger.Package, pkgName, key, value, src, isErr))
+	}
+	return strings.Join(res, ", "), true
+}
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// errorMethodReceiver возвращает err для выражения err.Error()
+func errorMethodReceiver(pass *analysis.Pass, expr ast.Expr) (ast.Expr, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return nil, false
+	}
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Error" {
+		return nil, false
+	}
+	typ := pass.TypesInfo.TypeOf(sel.X)
+	if typ == nil || !types.Implements(typ, errorType) {
+		return nil, false
+	}
+	return sel.X, true
+}
+
+// typedFieldFuncs — функции пакета логера для полей известных типов; для остальных типов используется Any
+var typedFieldFuncs = map[string]map[string]string{
+	slogPackage: {
+		"string":        "String",
+		"bool":          "Bool",
+		"int":           "Int",
+		"int64":         "Int64",
+		"uint64":        "Uint64",
+		"float64":       "Float64",
+		"time.Duration": "Duration",
+		"time.Time":     "Time",
+	},
+	zapPackage: {
+		"string":        "String",
+		"bool":          "Bool",
+		"int":           "Int",
+		"int8":          "Int8",
+		"int16":         "Int16",
+		"int32":         "Int32",
+		"int64":         "Int64",
+		"uint":          "Uint",
+		"uint8":         "Uint8",
+		"uint16":        "Uint16",
+		"uint32":        "Uint32",
+		"uint64":        "Uint64",
+		"float32":       "Float32",
+		"float64":       "Float64",
+		"time.Duration": "Duration",
+		"time.Time":     "Time",
+	},
+}
+
+// typedField возвращает поле пакета логера для значения: slog.String("id", id), zap.Duration("took", d)
+func typedField(pass *analysis.Pass, pkgPath, pkgName, key string, value ast.Expr, src string, isErr bool) string {
+	if isErr && pkgPath == zapPackage {
+		if key == "error" {
+			return fmt.Sprintf("%s.Error(%s)", pkgName, src)
+		}
+		return fmt.Sprintf("%s.NamedError(%q, %s)", pkgName, key, src)
+	}
+	fn := "Any"
+	if typ := pass.TypesInfo.TypeOf(value); typ != nil && !isErr {
+		if name, ok := typedFieldFuncs[pkgPath][types.TypeString(types.Default(typ), nil)]; ok {
+			fn = name
+		}
+	}
+	return fmt.Sprintf("%s.%s(%q, %s)", pkgName, fn, key, src)
+}
+
+// fieldKeyName выводит ключ поля из выражения значения в snake_case: userID -> user_id, u.Name() -> name.
+// Для преобразований в строку ключ берётся из преобразуемого значения: took.String(), strconv.Itoa(n).
+// Имена функций fmt ключом не становятся: fmt.Sprintf(...) -> value.
+func fieldKeyName(pass *analysis.Pass, expr ast.Expr) string {
+	if call, ok := ast.Unparen(expr).(*ast.CallExpr); ok {
+		if fn, ok := calledFunc(pass, call); ok && fn.Pkg() != nil && fn.Pkg().Path() == "fmt" && len(call.Args) != 1 {
+			return "value"
+		}
+	}
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return snakeCase(e.Name)
+	case *ast.SelectorExpr:
+		return snakeCase(e.Sel.Name)
+	case *ast.StarExpr:
+		return fieldKeyName(pass, e.X)
+	case *ast.CallExpr:
+		sel, ok := ast.Unparen(e.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return fieldKeyName(pass, e.Fun)
+		}
+		if _, isPkg := pass.TypesInfo.Uses[identOf(sel.X)].(*types.PkgName); isPkg && len(e.Args) == 1 {
+			return fieldKeyName(pass, e.Args[0])
+		}
+		if sel.Sel.Name == "String" && len(e.Args) == 0 {
+			return fieldKeyName(pass, sel.X)
+		}
+		return snakeCase(sel.Sel.Name)
+	}
+	return "value"
+}
+
+func identOf(expr ast.Expr) *ast.Ident {
+	ident, _ := ast.Unparen(expr).(*ast.Ident)
+	return ident
+}
+
+// snakeCase переводит идентификатор Go в snake_case: HTTPServer -> http_server
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// exprSource возвращает исходный текст выражения
+func exprSource(fset *token.FileSet, expr ast.Expr) (string, bool) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		return "", false
+	}
+	return buf.String(), true
+}
+
+// importName возвращает имя, под которым пакет импортирован в файле с указанной позицией
+func importName(pass *analysis.Pass, pos token.Pos, path string) string {
+	for _, file := range pass.Files {
+		if pos < file.FileStart || pos > file.FileEnd {
+			continue
+		}
+		for _, spec := range file.Imports {
+			if p, err := strconv.Unquote(spec.Path.Value); err != nil || p != path {
+				continue
+			}
+			if spec.Name != nil {
+				if spec.Name.Name == "_" || spec.Name.Name == "." {
+					return ""
+				}
+				return spec.Name.Name
+			}
+			if pkgName, ok := pass.TypesInfo.Implicits[spec].(*types.PkgName); ok {
+				return pkgName.Name()
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,24 @@
+package analyzer
+
+import "testing"
+
+func Test_snakeCase(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "id", want: "id"},
+		{name: "userID", want: "user_id"},
+		{name: "HTTPServer", want: "http_server"},
+		{name: "requestCount2", want: "request_count2"},
+		{name: "retry2Times", want: "retry2_times"},
+		{name: "Name", want: "name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snakeCase(tt.name); got != tt.want {
+				t.Errorf("snakeCase(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
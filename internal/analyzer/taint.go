@@ -0,0 +1,433 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+)
+
+// valueTracer находит источники значений, попадающих в вызовы логеров.
+// Выражение из исходного кода сопоставляется со значением SSA через инструкцию, которая его использует:
+// аргумент вызова, операнд конкатенации или значение литерала map.
+// SSA строится только для анализируемого пакета и только когда значение не удалось проверить по имени.
+type valueTracer struct {
+	pass   *analysis.Pass
+	instrs map[token.Pos]ssa.Instruction // вызовы по позиции "(", конкатенации по позиции "+", map по позиции ":"
+}
+
+func newValueTracer(pass *analysis.Pass) *valueTracer {
+	return &valueTracer{pass: pass}
+}
+
+// build строит SSA пакета; пакеты зависимостей (включая транзитивные) создаются только из информации
+// о типах, как в buildssa. Сам buildssa не используется: анализатор экспортирует факты, поэтому
+// выполняется и для зависимостей, а buildssa падает на пакетах стандартной библиотеки, синтаксис
+// которых не поддерживается используемой версией x/tools. Кроме того, SSA нужен лишь немногим пакетам.
+func (t *valueTracer) build() {
+	if t.instrs != nil {
+		return
+	}
+	t.instrs = make(map[token.Pos]ssa.Instruction)
+	prog := ssa.NewProgram(t.pass.Fset, 0)
+	created := make(map[*types.Package]bool)
+	var createAll func(pkgs []*types.Package)
+	createAll = func(pkgs []*types.Package) {
+		for _, p := range pkgs {
+			if !created[p] {
+				created[p] = true
+				prog.CreatePackage(p, nil, nil, true)
+				createAll(p.Imports())
+			}
+		}
+	}
+	createAll(t.pass.Pkg.Imports())
+	pkg := prog.CreatePackage(t.pass.Pkg, t.pass.Files, t.pass.TypesInfo, false)
+	pkg.Build()
+	for fn := range ssautil.AllFunctions(prog) {
+		if fn.Pkg != pkg {
+			continue
+		}
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				switch instr := instr.(type) {
+				case ssa.CallInstruction:
+					t.instrs[instr.Common().Pos()] = instr
+				case *ssa.BinOp, *ssa.MapUpdate:
+					t.instrs[instr.Pos()] = instr
+				}
+			}
+		}
+	}
+}
+
+// valueOf возвращает значение SSA выражения, переданного в вызов, конкатенацию или литерал map
+func (t *valueTracer) valueOf(expr ast.Expr) (ssa.Value, bool) {
+	file := fileOf(t.pass, expr.Pos())
+	if file == nil {
+		return nil, false
+	}
+	t.build()
+	path, _ := astutil.PathEnclosingInterval(file, expr.Pos(), expr.End())
+	// path[0] — само выражение, выше — скобки вокруг него
+	i := 1
+	for i < len(path) {
+		if _, ok := path[i].(*ast.ParenExpr); !ok {
+			break
+		}
+		expr = path[i].(*ast.ParenExpr)
+		i++
+	}
+	if i >= len(path) {
+		return nil, false
+	}
+	switch parent := path[i].(type) {
+	case *ast.CallExpr:
+		for idx, arg := range parent.Args {
+			if arg == expr {
+				return t.argValue(parent, idx)
+			}
+		}
+	case *ast.BinaryExpr:
+		binOp, ok := t.instrs[parent.OpPos].(*ssa.BinOp)
+		if !ok {
+			return nil, false
+		}
+		if parent.X == expr {
+			return binOp.X, true
+		}
+		return binOp.Y, true
+	case *ast.KeyValueExpr:
+		if update, ok := t.instrs[parent.Colon].(*ssa.MapUpdate); ok && parent.Value == expr {
+			return update.Value, true
+		}
+	}
+	return nil, false
+}
+
+// argValue возвращает значение SSA аргумента вызова с индексом idx.
+// Аргументы variadic-параметра SSA собирает в срез: значение ищется среди записей в его массив.
+func (t *valueTracer) argValue(call *ast.CallExpr, idx int) (ssa.Value, bool) {
+	instr, ok := t.instrs[call.Lparen].(ssa.CallInstruction)
+	if !ok {
+		return nil, false
+	}
+	common := instr.Common()
+	args := common.Args
+	sig := common.Signature()
+	if !common.IsInvoke() && sig.Recv() != nil && len(args) > 0 {
+		// статический вызов метода: первым аргументом идёт получатель
+		args = args[1:]
+	}
+	last := sig.Params().Len() - 1
+	if sig.Variadic() && !call.Ellipsis.IsValid() && idx >= last {
+		if last < 0 || last >= len(args) {
+			return nil, false
+		}
+		return variadicElem(args[last], idx-last)
+	}
+	if idx >= len(args) {
+		return nil, false
+	}
+	return args[idx], true
+}
+
+// variadicElem находит значение, записанное в k-й элемент среза аргументов variadic-параметра
+func variadicElem(v ssa.Value, k int) (ssa.Value, bool) {
+	slice, ok := v.(*ssa.Slice)
+	if !ok {
+		return nil, false
+	}
+	alloc, ok := slice.X.(*ssa.Alloc)
+	if !ok || alloc.Referrers() == nil {
+		return nil, false
+	}
+	for _, ref := range *alloc.Referrers() {
+		addr, ok := ref.(*ssa.IndexAddr)
+		if !ok || addr.Referrers() == nil {
+			continue
+		}
+		if c, ok := addr.Index.(*ssa.Const); !ok || c.Int64() != int64(k) {
+			continue
+		}
+		for _, ref := range *addr.Referrers() {
+			if store, ok := ref.(*ssa.Store); ok && store.Addr == addr {
+				return store.Val, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// source ищет среди источников значения имя переменной, поля, параметра или метода,
+// похожее на чувствительные данные: p := cfg.DBPassword -> DBPassword
+func (t *valueTracer) source(v ssa.Value, cfg Config, seen map[ssa.Value]bool) (name, keyword string, ok bool) {
+	if v == nil || seen[v] {
+		return "", "", false
+	}
+	seen[v] = true
+	switch v := v.(type) {
+	case *ssa.MakeInterface:
+		return t.source(v.X, cfg, seen)
+	case *ssa.ChangeType:
+		return t.source(v.X, cfg, seen)
+	case *ssa.Convert:
+		return t.source(v.X, cfg, seen)
+	case *ssa.ChangeInterface:
+		return t.source(v.X, cfg, seen)
+	case *ssa.Slice:
+		return t.source(v.X, cfg, seen)
+	case *ssa.UnOp:
+		if v.Op == token.MUL {
+			return t.addrSource(v.X, cfg, seen)
+		}
+	case *ssa.Field:
+		if st, ok := v.X.Type().Underlying().(*types.Struct); ok {
+			return sensitiveName(st.Field(v.Field).Name(), cfg)
+		}
+	case *ssa.Parameter:
+		return sensitiveName(v.Name(), cfg)
+	case *ssa.FreeVar:
+		if name, keyword, ok := sensitiveName(v.Name(), cfg); ok {
+			return name, keyword, true
+		}
+		return t.source(closureBinding(v), cfg, seen)
+	case *ssa.Global:
+		return sensitiveName(v.Name(), cfg)
+	case *ssa.Call:
+		return t.callSource(&v.Call, cfg, seen)
+	case *ssa.Extract:
+		if call, ok := v.Tuple.(*ssa.Call); ok {
+			return t.callSource(&call.Call, cfg, seen)
+		}
+	case *ssa.Phi:
+		for _, edge := range v.Edges {
+			if name, keyword, ok := t.source(edge, cfg, seen); ok {
+				return name, keyword, true
+			}
+		}
+	case *ssa.BinOp:
+		if v.Op == token.ADD {
+			if name, keyword, ok := t.source(v.X, cfg, seen); ok {
+				return name, keyword, true
+			}
+			return t.source(v.Y, cfg, seen)
+		}
+	}
+	return "", "", false
+}
+
+// addrSource ищет источник значения, прочитанного по адресу: поле структуры, глобальная
+// или захваченная замыканием переменная, локальная переменная в куче
+func (t *valueTracer) addrSource(addr ssa.Value, cfg Config, seen map[ssa.Value]bool) (string, string, bool) {
+	switch addr := addr.(type) {
+	case *ssa.FieldAddr:
+		if ptr, ok := addr.X.Type().Underlying().(*types.Pointer); ok {
+			if st, ok := ptr.Elem().Underlying().(*types.Struct); ok {
+				return sensitiveName(st.Field(addr.Field).Name(), cfg)
+			}
+		}
+	case *ssa.Global:
+		return sensitiveName(addr.Name(), cfg)
+	case *ssa.FreeVar:
+		if name, keyword, ok := sensitiveName(addr.Name(), cfg); ok {
+			return name, keyword, true
+		}
+		return t.addrSource(closureBinding(addr), cfg, seen)
+	case *ssa.Alloc:
+		if name, keyword, ok := sensitiveName(addr.Comment, cfg); ok {
+			return name, keyword, true
+		}
+		if addr.Referrers() == nil {
+			return "", "", false
+		}
+		for _, ref := range *addr.Referrers() {
+			if store, ok := ref.(*ssa.Store); ok && store.Addr == addr {
+				if name, keyword, ok := t.source(store.Val, cfg, seen); ok {
+					return name, keyword, true
+				}
+			}
+		}
+	}
+	return "", "", false
+}
+
+// closureBinding возвращает значение, захваченное замыканием в объемлющей функции
+func closureBinding(fv *ssa.FreeVar) ssa.Value {
+	fn := fv.Parent()
+	idx := slices.Index(fn.FreeVars, fv)
+	if fn.Parent() == nil || idx < 0 {
+		return nil
+	}
+	for _, b := range fn.Parent().Blocks {
+		for _, instr := range b.Instrs {
+			if mc, ok := instr.(*ssa.MakeClosure); ok && mc.Fn == fn && idx < len(mc.Bindings) {
+				return mc.Bindings[idx]
+			}
+		}
+	}
+	return nil
+}
+
+// callSource проверяет имя вызванной функции или метода: GetPassword(), creds.Token().
+// Для String() проверяется получатель: password.String()
+func (t *valueTracer) callSource(call *ssa.CallCommon, cfg Config, seen map[ssa.Value]bool) (string, string, bool) {
+	var name string
+	switch {
+	case call.IsInvoke():
+		name = call.Method.Name()
+	case call.StaticCallee() != nil:
+		name = call.StaticCallee().Name()
+	}
+	if name == "" {
+		return "", "", false
+	}
+	if name == "String" && len(call.Args) == 1 && call.Signature().Recv() != nil {
+		return t.source(call.Args[0], cfg, seen)
+	}
+	if _, ok := call.Value.(*ssa.Builtin); ok {
+		return "", "", false
+	}
+	return sensitiveName(name, cfg)
+}
+
+// sensitiveName проверяет имя переменной, поля или метода на чувствительные слова
+func sensitiveName(name string, cfg Config) (string, string, bool) {
+	if name == "" {
+		return "", "", false
+	}
+	if ok, keyword := checkSensitiveKeys(name, targetKey, cfg); ok {
+		return name, keyword, true
+	}
+	return "", "", false
+}
+
+// exprName возвращает имя, по которому выражение видно в исходном коде:
+// переменная, последний элемент цепочки полей, вызванная функция или метод
+func exprName(pass *analysis.Pass, expr ast.Expr) string {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.StarExpr:
+		return exprName(pass, e.X)
+	case *ast.CallExpr:
+		// преобразование типа: string(password)
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return exprName(pass, e.Args[0])
+		}
+		if sel, ok := ast.Unparen(e.Fun).(*ast.SelectorExpr); ok && sel.Sel.Name == "String" && len(e.Args) == 0 {
+			return exprName(pass, sel.X)
+		}
+		if fn, ok := calledFunc(pass, e); ok {
+			return fn.Name()
+		}
+	}
+	return ""
+}
+
+// checkSensitiveValue сообщает о значении, имя или источник которого похожи на чувствительные данные:
+//
+//	logger.Info("user", zap.String("name", u.Password))
+//	p := cfg.DBPassword
+//	slog.Info("connecting", "dsn", p)
+//
+// what описывает место значения в вызове: "log message" или `log field "name"`.
+// Логические и числовые значения (passwordLen, hasToken) и ошибки не проверяются.
+func checkSensitiveValue(pass *analysis.Pass, tracer *valueTracer, expr ast.Expr, what string, cfg Config) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value != nil || tv.Type == nil || types.Implements(tv.Type, errorType) || isRedacted(pass, expr, cfg) {
+		return
+	}
+	if basic, ok := tv.Type.Underlying().(*types.Basic); ok && basic.Info()&(types.IsBoolean|types.IsNumeric) != 0 {
+		return
+	}
+	src := types.ExprString(expr)
+	if _, keyword, ok := sensitiveName(exprName(pass, expr), cfg); ok {
+		report(pass, cfg, RuleSensitiveValue, analysis.Diagnostic{
+			Pos:            expr.Pos(),
+			Message:        fmt.Sprintf("%s value %s may contain sensitive data (found %q)", what, src, keyword),
+			SuggestedFixes: redactFix(pass, []ast.Expr{expr}, cfg),
+		})
+		return
+	}
+	if tracer == nil {
+		return
+	}
+	v, ok := tracer.valueOf(expr)
+	if !ok {
+		return
+	}
+	if name, keyword, ok := tracer.source(v, cfg, make(map[ssa.Value]bool)); ok {
+		report(pass, cfg, RuleSensitiveValue, analysis.Diagnostic{
+			Pos:            expr.Pos(),
+			Message:        fmt.Sprintf("%s value %s may contain sensitive data (found %q in %s)", what, src, keyword, name),
+			SuggestedFixes: redactFix(pass, []ast.Expr{expr}, cfg),
+		})
+	}
+}
+
+// checkMessageValues проверяет динамические значения сообщения и аргументы строки формата.
+// Аргументы, которые строка формата выводит целиком, проверяются на чувствительные типы.
+// Имена значений не проверяются, если о постоянном тексте сообщения sensitive-data уже сообщил:
+// "password: " + password
+func checkMessageValues(pass *analysis.Pass, tracer *valueTracer, sens *sensitiveTypes, callExpr *ast.CallExpr,
+	logger *LoggerConfig, text string, cfg Config) {
+	msgExpr := callExpr.Args[logger.MessageIndex]
+	const what = "log message"
+	var formatted []ast.Expr
+	if logger.Printf {
+		formatted = formatValueArgs(pass, msgExpr, callExpr.Args[logger.MessageIndex+1:])
+	} else if call, ok := sprintfCall(pass, msgExpr); ok {
+		formatted = formatValueArgs(pass, call.Args[0], call.Args[1:])
+	}
+	typed := make(map[ast.Expr]bool)
+	for _, arg := range formatted {
+		typed[arg] = sens.check(arg, what, cfg)
+	}
+	if ok, _ := checkSensitiveKeys(text, targetMessage, cfg); ok {
+		return
+	}
+	check := func(expr ast.Expr) {
+		if !typed[expr] {
+			checkSensitiveValue(pass, tracer, expr, what, cfg)
+		}
+	}
+	if parts, dynamic, _ := dynamicMessageParts(pass, msgExpr); len(parts) > 0 {
+		for _, part := range parts {
+			if part.value != nil {
+				check(part.value)
+			}
+		}
+	} else if !dynamic {
+		check(msgExpr)
+	}
+	if logger.Printf {
+		for _, arg := range callExpr.Args[logger.MessageIndex+1:] {
+			check(arg)
+		}
+	}
+}
+
+// checkFieldValue проверяет значение поля, ключ которого сам не похож на чувствительные данные:
+// о чувствительном ключе уже сообщает sensitive-data. Сначала проверяется тип значения, затем имя.
+func checkFieldValue(pass *analysis.Pass, tracer *valueTracer, sens *sensitiveTypes, key fieldKey, cfg Config) {
+	if key.value == nil {
+		return
+	}
+	if ok, _ := checkSensitiveKeys(key.name, targetKey, cfg); ok {
+		return
+	}
+	what := fmt.Sprintf("log field %q", key.name)
+	if sens.check(key.value, what, cfg) {
+		return
+	}
+	checkSensitiveValue(pass, tracer, key.value, what, cfg)
+}
@@ -0,0 +1,110 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// wrapperFact помечает функцию-обёртку над логером: функция передаёт свой строковый параметр
+// как сообщение вызова логера. Вызовы обёртки проверяются так же, как вызовы самого логера.
+// Методы не заполняются: факт относится к конкретной функции.
+type wrapperFact LoggerConfig
+
+func (*wrapperFact) AFact() {}
+
+func (f *wrapperFact) String() string {
+	return fmt.Sprintf("logWrapper(message=%d, printf=%t, fields=%s)", f.MessageIndex, f.Printf, f.Fields)
+}
+
+// exportWrapperFacts находит в пакете функции-обёртки над логерами и экспортирует для них факты.
+// Обёртки могут вызывать другие обёртки того же пакета, поэтому поиск повторяется,
+// пока находятся новые.
+func exportWrapperFacts(pass *analysis.Pass, loggers loggerIndex) {
+	var funcs []*ast.FuncDecl
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Body != nil {
+				funcs = append(funcs, fd)
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, fd := range funcs {
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok || pass.ImportObjectFact(fn, new(wrapperFact)) {
+				continue
+			}
+			if fact, ok := detectWrapper(pass, loggers, fd, fn); ok {
+				pass.ExportObjectFact(fn, fact)
+				changed = true
+			}
+		}
+	}
+}
+
+// detectWrapper ищет в теле функции вызов логера, сообщением которого является параметр функции
+func detectWrapper(pass *analysis.Pass, loggers loggerIndex, fd *ast.FuncDecl, fn *types.Func) (*wrapperFact, bool) {
+	sig := fn.Type().(*types.Signature)
+	var fact *wrapperFact
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if fact != nil {
+			return false
+		}
+		callExpr, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		logger, ok := loggers.lookup(pass, callExpr)
+		if !ok || logger.MessageIndex < 0 || logger.MessageIndex >= len(callExpr.Args) {
+			return true
+		}
+		msgParam, ok := paramIndex(pass, sig, callExpr.Args[logger.MessageIndex])
+		if !ok || !isStringType(sig.Params().At(msgParam).Type()) {
+			return true
+		}
+		fact = &wrapperFact{
+			Package:      logger.Package,
+			MessageIndex: msgParam,
+			Fields:       FieldsNone,
+		}
+		// поля и операнды формата передаются дальше, только если обёртка пробрасывает свой
+		// variadic-параметр, идущий сразу после сообщения:
+		// func logErr(msg string, args ...any) { slog.Error(msg, args...) }
+		if callExpr.Ellipsis.IsValid() && sig.Variadic() {
+			last := callExpr.Args[len(callExpr.Args)-1]
+			if idx, ok := paramIndex(pass, sig, last); ok && idx == sig.Params().Len()-1 && idx == msgParam+1 {
+				if logger.Fields != FieldsChain {
+					fact.Fields = logger.Fields
+				}
+				// func logf(format string, args ...any) { sugar.Infof(format, args...) }
+				fact.Printf = logger.Printf && len(callExpr.Args) == logger.MessageIndex+2
+			}
+		}
+		return false
+	})
+	return fact, fact != nil
+}
+
+// paramIndex возвращает индекс параметра функции, на который ссылается выражение
+func paramIndex(pass *analysis.Pass, sig *types.Signature, expr ast.Expr) (int, bool) {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return 0, false
+	}
+	obj := pass.TypesInfo.Uses[ident]
+	for i := 0; i < sig.Params().Len(); i++ {
+		if sig.Params().At(i) == obj {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func isStringType(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
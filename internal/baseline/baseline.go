@@ -0,0 +1,130 @@
+// Package baseline хранит известные нарушения линтера, чтобы при внедрении
+// на существующем коде сообщать только о новых.
+package baseline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// version — версия формата файла baseline
+const version = 1
+
+// Finding — нарушение, найденное линтером
+type Finding struct {
+	File    string // путь к файлу относительно корня проекта, через "/"
+	Line    int
+	Column  int
+	Rule    string // идентификатор правила (Category диагностики)
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s", f.File, f.Line, f.Column, f.Message)
+}
+
+// Entry — запись baseline: нарушения одного правила с одинаковым сообщением в одном файле.
+// Номер строки не хранится, поэтому запись не устаревает при сдвиге кода.
+type Entry struct {
+	File        string `json:"file"`
+	Rule        string `json:"rule"`
+	Fingerprint string `json:"fingerprint"`
+	Count       int    `json:"count"`
+}
+
+// Baseline — набор известных нарушений
+type Baseline struct {
+	Version int     `json:"version"`
+	Entries []Entry `json:"entries"`
+}
+
+type entryKey struct {
+	file, rule, fingerprint string
+}
+
+// Fingerprint возвращает отпечаток сообщения диагностики. Перед хешированием сообщение
+// нормализуется: убираются префикс уровня важности и повторяющиеся пробелы.
+func Fingerprint(rule, message string) string {
+	for _, prefix := range []string{"warning: ", "info: "} {
+		message = strings.TrimPrefix(message, prefix)
+	}
+	normalized := strings.Join(strings.Fields(message), " ")
+	sum := sha256.Sum256([]byte(rule + "\x00" + normalized))
+	return hex.EncodeToString(sum[:8])
+}
+
+func keyOf(f Finding) entryKey {
+	return entryKey{file: f.File, rule: f.Rule, fingerprint: Fingerprint(f.Rule, f.Message)}
+}
+
+// New создаёт baseline из текущих нарушений
+func New(findings []Finding) *Baseline {
+	counts := make(map[entryKey]int)
+	for _, f := range findings {
+		counts[keyOf(f)]++
+	}
+	b := &Baseline{Version: version, Entries: make([]Entry, 0, len(counts))}
+	for k, n := range counts {
+		b.Entries = append(b.Entries, Entry{File: k.file, Rule: k.rule, Fingerprint: k.fingerprint, Count: n})
+	}
+	sort.Slice(b.Entries, func(i, j int) bool {
+		a, c := b.Entries[i], b.Entries[j]
+		if a.File != c.File {
+			return a.File < c.File
+		}
+		if a.Rule != c.Rule {
+			return a.Rule < c.Rule
+		}
+		return a.Fingerprint < c.Fingerprint
+	})
+	return b
+}
+
+// Filter возвращает нарушения, которых нет в baseline. Если одинаковых нарушений в файле
+// стало больше, чем записано в baseline, новыми считаются лишние.
+func (b *Baseline) Filter(findings []Finding) []Finding {
+	remaining := make(map[entryKey]int, len(b.Entries))
+	for _, e := range b.Entries {
+		remaining[entryKey{file: e.File, rule: e.Rule, fingerprint: e.Fingerprint}] += e.Count
+	}
+	var fresh []Finding
+	for _, f := range findings {
+		k := keyOf(f)
+		if remaining[k] > 0 {
+			remaining[k]--
+			continue
+		}
+		fresh = append(fresh, f)
+	}
+	return fresh
+}
+
+// Load читает baseline из файла
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("parse baseline %s: %w", path, err)
+	}
+	if b.Version != version {
+		return nil, fmt.Errorf("baseline %s: unsupported version %d", path, b.Version)
+	}
+	return &b, nil
+}
+
+// Write записывает baseline в файл
+func (b *Baseline) Write(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
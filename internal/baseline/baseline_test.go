@@ -0,0 +1,74 @@
+package baseline
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	base := Fingerprint("lowercase-start", `log message should start with a lowercase letter: "Hello"`)
+	tests := []struct {
+		name    string
+		rule    string
+		message string
+		same    bool
+	}{
+		{name: "same message", rule: "lowercase-start", message: `log message should start with a lowercase letter: "Hello"`, same: true},
+		{name: "severity prefix", rule: "lowercase-start", message: `warning: log message should start with a lowercase letter: "Hello"`, same: true},
+		{name: "extra spaces", rule: "lowercase-start", message: `log message  should start with a lowercase letter: "Hello"`, same: true},
+		{name: "other rule", rule: "english-only", message: `log message should start with a lowercase letter: "Hello"`, same: false},
+		{name: "other message", rule: "lowercase-start", message: `log message should start with a lowercase letter: "World"`, same: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Fingerprint(tt.rule, tt.message) == base; got != tt.same {
+				t.Errorf("Fingerprint() equal = %v, want %v", got, tt.same)
+			}
+		})
+	}
+}
+
+func TestBaseline_Filter(t *testing.T) {
+	known := []Finding{
+		{File: "a.go", Line: 10, Rule: "lowercase-start", Message: "m1"},
+		{File: "a.go", Line: 20, Rule: "lowercase-start", Message: "m1"},
+		{File: "b.go", Line: 5, Rule: "sensitive-data", Message: "m2"},
+	}
+	b := New(known)
+
+	current := []Finding{
+		// сдвинулись строки — нарушения остаются известными
+		{File: "a.go", Line: 12, Rule: "lowercase-start", Message: "m1"},
+		{File: "a.go", Line: 22, Rule: "lowercase-start", Message: "m1"},
+		// третье одинаковое нарушение в файле — новое
+		{File: "a.go", Line: 30, Rule: "lowercase-start", Message: "m1"},
+		// то же сообщение в другом файле — новое
+		{File: "c.go", Line: 5, Rule: "sensitive-data", Message: "m2"},
+	}
+	want := []Finding{current[2], current[3]}
+	if got := b.Filter(current); !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+}
+
+func TestBaseline_WriteLoad(t *testing.T) {
+	b := New([]Finding{
+		{File: "a.go", Line: 1, Rule: "english-only", Message: "m"},
+		{File: "a.go", Line: 2, Rule: "english-only", Message: "m"},
+	})
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := b.Write(path); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, b) {
+		t.Errorf("Load() = %+v, want %+v", got, b)
+	}
+	if got.Entries[0].Count != 2 {
+		t.Errorf("Count = %d, want 2", got.Entries[0].Count)
+	}
+}
@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"fmt"
+
 	"github.com/danyarmarkin/prettyloglint/internal/analyzer"
 	"github.com/golangci/plugin-module-register/register"
 
@@ -12,25 +14,19 @@ func init() {
 }
 
 type analyzerPlugin struct {
-	cfg analyzer.Config
+	analyzer *analysis.Analyzer
 }
 
 func (p *analyzerPlugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
-	return []*analysis.Analyzer{
-		analyzer.NewAnalyzer(p.cfg),
-	}, nil
+	return []*analysis.Analyzer{p.analyzer}, nil
 }
 
 func (p *analyzerPlugin) GetLoadMode() string {
-	return register.LoadModeSyntax
+	return register.LoadModeTypesInfo
 }
 
 func New(conf any) (register.LinterPlugin, error) {
-	cfg := analyzer.Config{
-		AllowedPunctuation:      ",-/:()",
-		CustomSensitivePatterns: []string{},
-		IgnoreZapFields:         false,
-	}
+	cfg := analyzer.DefaultConfig()
 	if confMap, ok := conf.(map[string]interface{}); ok {
 		if ap, ok := confMap["allowed-punctuation"].(string); ok {
 			cfg.AllowedPunctuation = ap
@@ -42,9 +38,152 @@ func New(conf any) (register.LinterPlugin, error) {
 				}
 			}
 		}
+		switch et := confMap["entropy-threshold"].(type) {
+		case float64:
+			cfg.EntropyThreshold = et
+		case int:
+			cfg.EntropyThreshold = float64(et)
+		}
 		if izf, ok := confMap["ignore-zap-fields"].(bool); ok {
 			cfg.IgnoreZapFields = izf
 		}
+		if ks, ok := confMap["key-style"].(string); ok {
+			cfg.KeyStyle = ks
+		}
+		if loggers, ok := confMap["loggers"].([]interface{}); ok {
+			for _, v := range loggers {
+				if lm, ok := v.(map[string]interface{}); ok {
+					cfg.Loggers = append(cfg.Loggers, parseLogger(lm))
+				}
+			}
+		}
+		if pii, ok := confMap["pii"].(map[string]interface{}); ok {
+			parsePII(pii, &cfg.PII)
+		}
+		if r, ok := confMap["redactor"].(string); ok {
+			cfg.Redactor = r
+		}
+		if rud, ok := confMap["report-unused-directives"].(bool); ok {
+			cfg.ReportUnusedDirectives = rud
+		}
+		if rdr, ok := confMap["require-directive-reason"].(bool); ok {
+			cfg.RequireDirectiveReason = rdr
+		}
+		if sk, ok := confMap["safe-keys"].([]interface{}); ok {
+			for _, v := range sk {
+				if s, ok := v.(string); ok {
+					cfg.SafeKeys = append(cfg.SafeKeys, s)
+				}
+			}
+		}
+		if sp, ok := confMap["sensitive-patterns"].([]interface{}); ok {
+			for _, v := range sp {
+				if pm, ok := v.(map[string]interface{}); ok {
+					cfg.SensitivePatterns = append(cfg.SensitivePatterns, parseSensitivePattern(pm))
+				}
+			}
+		}
+		if st, ok := confMap["sensitive-types"].([]interface{}); ok {
+			for _, v := range st {
+				if s, ok := v.(string); ok {
+					cfg.SensitiveTypes = append(cfg.SensitiveTypes, s)
+				}
+			}
+		}
+		if rules, ok := confMap["rules"].(map[string]interface{}); ok {
+			cfg.Rules = make(map[string]analyzer.RuleConfig, len(rules))
+			for id, v := range rules {
+				if rm, ok := v.(map[string]interface{}); ok {
+					cfg.Rules[id] = parseRule(rm)
+				}
+			}
+		}
+	}
+	// настройки проверяются и шаблоны компилируются один раз при создании плагина
+	a, err := analyzer.NewAnalyzer(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("prettyloglint: %w", err)
+	}
+	return &analyzerPlugin{analyzer: a}, nil
+}
+
+// parseRule разбирает настройки правила из настроек golangci-lint
+func parseRule(rm map[string]interface{}) analyzer.RuleConfig {
+	var rc analyzer.RuleConfig
+	if enabled, ok := rm["enabled"].(bool); ok {
+		rc.Enabled = &enabled
+	}
+	if severity, ok := rm["severity"].(string); ok {
+		rc.Severity = analyzer.Severity(severity)
+	}
+	return rc
+}
+
+// parsePII включает или отключает детекторы персональных данных; не указанные детекторы остаются включёнными
+func parsePII(pm map[string]interface{}, pii *analyzer.PIIConfig) {
+	if email, ok := pm["email"].(bool); ok {
+		pii.Email = email
+	}
+	if ip, ok := pm["ip"].(bool); ok {
+		pii.IP = ip
+	}
+	if phone, ok := pm["phone"].(bool); ok {
+		pii.Phone = phone
+	}
+	if card, ok := pm["card"].(bool); ok {
+		pii.Card = card
+	}
+}
+
+// parseSensitivePattern разбирает пользовательский шаблон чувствительных данных из настроек golangci-lint
+func parseSensitivePattern(pm map[string]interface{}) analyzer.SensitivePattern {
+	var p analyzer.SensitivePattern
+	if name, ok := pm["name"].(string); ok {
+		p.Name = name
+	}
+	if pattern, ok := pm["pattern"].(string); ok {
+		p.Pattern = pattern
+	}
+	if cs, ok := pm["case-sensitive"].(bool); ok {
+		p.CaseSensitive = cs
+	}
+	if targets, ok := pm["targets"].([]interface{}); ok {
+		for _, t := range targets {
+			if s, ok := t.(string); ok {
+				p.Targets = append(p.Targets, s)
+			}
+		}
+	}
+	return p
+}
+
+// parseLogger разбирает описание логера из настроек golangci-lint
+func parseLogger(lm map[string]interface{}) analyzer.LoggerConfig {
+	l := analyzer.LoggerConfig{Fields: analyzer.FieldsNone}
+	if pkg, ok := lm["package"].(string); ok {
+		l.Package = pkg
+	}
+	if typ, ok := lm["type"].(string); ok {
+		l.Type = typ
+	}
+	if methods, ok := lm["methods"].([]interface{}); ok {
+		for _, m := range methods {
+			if s, ok := m.(string); ok {
+				l.Methods = append(l.Methods, s)
+			}
+		}
+	}
+	switch idx := lm["message-index"].(type) {
+	case int:
+		l.MessageIndex = idx
+	case float64:
+		l.MessageIndex = int(idx)
+	}
+	if printf, ok := lm["printf"].(bool); ok {
+		l.Printf = printf
+	}
+	if fields, ok := lm["fields"].(string); ok {
+		l.Fields = analyzer.FieldStyle(fields)
 	}
-	return &analyzerPlugin{cfg: cfg}, nil
+	return l
 }